@@ -15,14 +15,58 @@ type abb[K comparable, V any] struct {
 	raiz     *nodoABB[K, V]
 	cantidad int
 	cmp      func(K, K) int
+
+	// cmpOriginal es la función de comparación recibida, cuando cmp es un envoltorio que la valida o cuenta
+	// sus llamadas
+	cmpOriginal func(K, K) int
+
+	// metricas es nil salvo que el abb se haya creado con ConMetricas
+	metricas *Metricas
+}
+
+// ABB es el DiccionarioOrdenado implementado con un árbol binario de búsqueda. Además de las primitivas del
+// diccionario, permite sacar sus claves extremas para usarlo como cola de prioridad doble
+type ABB[K comparable, V any] interface {
+	DiccionarioOrdenado[K, V]
+	Visualizable[K, V]
+
+	// SacarMinimo borra y devuelve la menor clave junto con su dato. Si el diccionario está vacío, entra en
+	// pánico con un mensaje 'El diccionario esta vacio'
+	SacarMinimo() (K, V)
+
+	// SacarMaximo borra y devuelve la mayor clave junto con su dato. Si el diccionario está vacío, entra en
+	// pánico con un mensaje 'El diccionario esta vacio'
+	SacarMaximo() (K, V)
+
+	// IntentarSacarMinimo es como SacarMinimo, pero si el diccionario está vacío devuelve false
+	IntentarSacarMinimo() (K, V, bool)
+
+	// IntentarSacarMaximo es como SacarMaximo, pero si el diccionario está vacío devuelve false
+	IntentarSacarMaximo() (K, V, bool)
+
+	// Validar recorre el árbol verificando que cumpla la propiedad de ABB y que la cantidad de nodos coincida
+	// con Cantidad. Devuelve un error describiendo la primera violación encontrada, o nil si no hay
+	Validar() error
+
+	// Estadisticas devuelve las medidas de la forma del árbol, calculadas en un único recorrido de O(n)
+	Estadisticas() Estadisticas
+
+	// Transaccion abre una transacción sobre el diccionario. Ver Transaccion
+	Transaccion() Transaccion[K, V]
 }
 
-func CrearABB[K comparable, V any](cmp func(K, K) int) DiccionarioOrdenado[K, V] {
-	return &abb[K, V]{
+func CrearABB[K comparable, V any](cmp func(K, K) int, opciones ...OpcionABB) ABB[K, V] {
+	config, cmpEnvuelto := aplicarOpciones(cmp, opciones)
+	a := &abb[K, V]{
 		raiz:     nil,
 		cantidad: 0,
-		cmp:      cmp,
+		cmp:      cmpEnvuelto,
+		metricas: config.metricas,
+	}
+	if config.validarComparador || config.metricas != nil {
+		a.cmpOriginal = cmp
 	}
+	return a
 }
 
 func (a *abb[K, V]) Guardar(clave K, dato V) {
@@ -32,6 +76,9 @@ func (a *abb[K, V]) Guardar(clave K, dato V) {
 func (a *abb[K, V]) guardarRec(n *nodoABB[K, V], clave K, dato V) *nodoABB[K, V] {
 	if n == nil {
 		a.cantidad++
+		if a.metricas != nil {
+			a.metricas.NodosCreados.Add(1)
+		}
 		return &nodoABB[K, V]{clave: clave, dato: dato}
 	}
 	cmp := a.cmp(clave, n.clave)
@@ -127,6 +174,93 @@ func (a *abb[K, V]) buscarMin(n *nodoABB[K, V]) *nodoABB[K, V] {
 	return n
 }
 
+// buscarPiso devuelve el nodo con la mayor clave menor o igual a la indicada, o nil si no hay
+func (a *abb[K, V]) buscarPiso(clave K) *nodoABB[K, V] {
+	var piso *nodoABB[K, V]
+	for n := a.raiz; n != nil; {
+		cmp := a.cmp(clave, n.clave)
+		if cmp == 0 {
+			return n
+		}
+		if cmp < 0 {
+			n = n.izq
+		} else {
+			piso = n
+			n = n.der
+		}
+	}
+	return piso
+}
+
+// buscarTecho devuelve el nodo con la menor clave mayor o igual a la indicada, o nil si no hay
+func (a *abb[K, V]) buscarTecho(clave K) *nodoABB[K, V] {
+	var techo *nodoABB[K, V]
+	for n := a.raiz; n != nil; {
+		cmp := a.cmp(clave, n.clave)
+		if cmp == 0 {
+			return n
+		}
+		if cmp > 0 {
+			n = n.der
+		} else {
+			techo = n
+			n = n.izq
+		}
+	}
+	return techo
+}
+
+func (a *abb[K, V]) SacarMinimo() (K, V) {
+	clave, dato, ok := a.IntentarSacarMinimo()
+	if !ok {
+		panic("El diccionario esta vacio")
+	}
+	return clave, dato
+}
+
+func (a *abb[K, V]) SacarMaximo() (K, V) {
+	clave, dato, ok := a.IntentarSacarMaximo()
+	if !ok {
+		panic("El diccionario esta vacio")
+	}
+	return clave, dato
+}
+
+// IntentarSacarMinimo baja por los hijos izquierdos hasta el mínimo y lo reemplaza por su hijo derecho, sin
+// volver a buscarlo desde la raíz
+func (a *abb[K, V]) IntentarSacarMinimo() (K, V, bool) {
+	if a.raiz == nil {
+		var clave K
+		var dato V
+		return clave, dato, false
+	}
+	enlace := &a.raiz
+	for (*enlace).izq != nil {
+		enlace = &(*enlace).izq
+	}
+	minimo := *enlace
+	*enlace = minimo.der
+	a.cantidad--
+	return minimo.clave, minimo.dato, true
+}
+
+// IntentarSacarMaximo baja por los hijos derechos hasta el máximo y lo reemplaza por su hijo izquierdo
+func (a *abb[K, V]) IntentarSacarMaximo() (K, V, bool) {
+	if a.raiz == nil {
+		var clave K
+		var dato V
+		return clave, dato, false
+	}
+	enlace := &a.raiz
+	for (*enlace).der != nil {
+		enlace = &(*enlace).der
+	}
+	maximo := *enlace
+	*enlace = maximo.izq
+	a.cantidad--
+	return maximo.clave, maximo.dato, true
+}
+
 func (a *abb[K, V]) Cantidad() int {
 	return a.cantidad
 }
@@ -205,6 +339,9 @@ func (iterABB *iteradorABB[K, V]) panicIterABB() {
 }
 
 func (abb *abb[K, V]) Iterador() IterDiccionario[K, V] {
+	if abb.metricas != nil {
+		abb.metricas.Iteradores.Add(1)
+	}
 	pila := TDAPila.CrearPilaDinamica[*nodoABB[K, V]]()
 	iter := &iteradorABB[K, V]{pila: pila, cmp: abb.cmp, desde: nil, hasta: nil}
 	iter.apilarDesdeHasta(abb.raiz, iter.desde, iter.hasta)
@@ -212,6 +349,9 @@ func (abb *abb[K, V]) Iterador() IterDiccionario[K, V] {
 }
 
 func (abb *abb[K, V]) IteradorRango(desde *K, hasta *K) IterDiccionario[K, V] {
+	if abb.metricas != nil {
+		abb.metricas.Iteradores.Add(1)
+	}
 	pila := TDAPila.CrearPilaDinamica[*nodoABB[K, V]]()
 	iter := &iteradorABB[K, V]{pila: pila, cmp: abb.cmp, desde: desde, hasta: hasta}
 	iter.apilarDesdeHasta(abb.raiz, iter.desde, iter.hasta)
@@ -0,0 +1,102 @@
+package diccionario
+
+import (
+	"strings"
+	"tdas/diccionario/codificacion"
+)
+
+// abbCodificado guarda las claves ya codificadas con un codificacion.Codificador, por lo que el árbol sólo
+// compara bytes. El string interno es la codificación de la clave; se usa string y no []byte para que la
+// clave del abb sea comparable, y strings.Compare ordena igual que bytes.Compare
+type abbCodificado[K any, V any] struct {
+	arbol       *abb[string, V]
+	codificador codificacion.Codificador[K]
+}
+
+// CrearABBCodificado crea un DiccionarioPorComparador cuyas claves se ordenan según su codificación en bytes.
+// Como el codificador preserva el orden, el recorrido coincide con el orden natural de las claves, y las claves
+// quedan listas para volcarse en un almacenamiento ordenado por bytes. Si una clave no puede codificarse (por
+// ejemplo un NaN con codificacion.NaNInvalido), las primitivas entran en pánico con el error del codificador
+func CrearABBCodificado[K any, V any](codificador codificacion.Codificador[K]) DiccionarioPorComparador[K, V] {
+	return &abbCodificado[K, V]{arbol: &abb[string, V]{cmp: strings.Compare}, codificador: codificador}
+}
+
+func (a *abbCodificado[K, V]) codificar(clave K) string {
+	bytes, err := a.codificador.Codificar(nil, clave)
+	if err != nil {
+		panic(err)
+	}
+	return string(bytes)
+}
+
+func (a *abbCodificado[K, V]) decodificar(clave string) K {
+	decodificada, _, err := a.codificador.Decodificar([]byte(clave))
+	if err != nil {
+		panic(err)
+	}
+	return decodificada
+}
+
+// limite codifica un límite de rango conservando el nil
+func (a *abbCodificado[K, V]) limite(limite *K) *string {
+	if limite == nil {
+		return nil
+	}
+	codificado := a.codificar(*limite)
+	return &codificado
+}
+
+func (a *abbCodificado[K, V]) Guardar(clave K, dato V) {
+	a.arbol.Guardar(a.codificar(clave), dato)
+}
+
+func (a *abbCodificado[K, V]) Pertenece(clave K) bool {
+	return a.arbol.Pertenece(a.codificar(clave))
+}
+
+func (a *abbCodificado[K, V]) Obtener(clave K) V {
+	return a.arbol.Obtener(a.codificar(clave))
+}
+
+func (a *abbCodificado[K, V]) Borrar(clave K) V {
+	return a.arbol.Borrar(a.codificar(clave))
+}
+
+func (a *abbCodificado[K, V]) Cantidad() int {
+	return a.arbol.Cantidad()
+}
+
+func (a *abbCodificado[K, V]) Iterar(visitar func(K, V) bool) {
+	a.arbol.Iterar(func(clave string, dato V) bool { return visitar(a.decodificar(clave), dato) })
+}
+
+func (a *abbCodificado[K, V]) IterarRango(desde *K, hasta *K, visitar func(K, V) bool) {
+	a.arbol.IterarRango(a.limite(desde), a.limite(hasta), func(clave string, dato V) bool { return visitar(a.decodificar(clave), dato) })
+}
+
+// iteradorCodificado adapta el iterador del abb decodificando las claves
+type iteradorCodificado[K any, V any] struct {
+	iter IterDiccionario[string, V]
+	dic  *abbCodificado[K, V]
+}
+
+func (a *abbCodificado[K, V]) Iterador() IterPorComparador[K, V] {
+	return &iteradorCodificado[K, V]{iter: a.arbol.Iterador(), dic: a}
+}
+
+func (a *abbCodificado[K, V]) IteradorRango(desde *K, hasta *K) IterPorComparador[K, V] {
+	return &iteradorCodificado[K, V]{iter: a.arbol.IteradorRango(a.limite(desde), a.limite(hasta)), dic: a}
+}
+
+func (iter *iteradorCodificado[K, V]) HaySiguiente() bool {
+	return iter.iter.HaySiguiente()
+}
+
+func (iter *iteradorCodificado[K, V]) VerActual() (K, V) {
+	clave, dato := iter.iter.VerActual()
+	return iter.dic.decodificar(clave), dato
+}
+
+func (iter *iteradorCodificado[K, V]) Siguiente() {
+	iter.iter.Siguiente()
+}
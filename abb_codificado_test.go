@@ -0,0 +1,96 @@
+package diccionario_test
+
+import (
+	"math"
+	TDADiccionario "tdas/diccionario"
+	"tdas/diccionario/codificacion"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestABBCodificadoOrden(t *testing.T) {
+	t.Log("El diccionario codificado recorre las claves en su orden natural aunque sólo compare bytes")
+	dic := TDADiccionario.CrearABBCodificado[int64, string](codificacion.Entero[int64]())
+	for _, clave := range []int64{5, -3, 0, math.MinInt64, 100, -300} {
+		dic.Guardar(clave, "x")
+	}
+	claves := []int64{}
+	dic.Iterar(func(clave int64, _ string) bool {
+		claves = append(claves, clave)
+		return true
+	})
+	require.EqualValues(t, []int64{math.MinInt64, -300, -3, 0, 5, 100}, claves)
+
+	desde, hasta := int64(-3), int64(50)
+	iter := dic.IteradorRango(&desde, &hasta)
+	claves = claves[:0]
+	for iter.HaySiguiente() {
+		clave, _ := iter.VerActual()
+		claves = append(claves, clave)
+		iter.Siguiente()
+	}
+	require.EqualValues(t, []int64{-3, 0, 5}, claves)
+	require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.VerActual() })
+}
+
+func TestABBCodificadoTuplas(t *testing.T) {
+	t.Log("Las claves compuestas por tiempo y cadena se guardan, buscan y borran por su codificación")
+	type clave = codificacion.Par[time.Time, string]
+	dic := TDADiccionario.CrearABBCodificado[clave, int](
+		codificacion.CodificadorPar(codificacion.Tiempo(), codificacion.Cadena()))
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	nueva := func(instante time.Time, nombre string) clave {
+		return clave{Primero: instante, Segundo: nombre}
+	}
+	dic.Guardar(nueva(base.Add(time.Hour), "b"), 3)
+	dic.Guardar(nueva(base, "b"), 2)
+	dic.Guardar(nueva(base, "a"), 1)
+
+	require.EqualValues(t, 3, dic.Cantidad())
+	require.True(t, dic.Pertenece(nueva(base.In(time.FixedZone("ART", -3*60*60)), "a")))
+	require.EqualValues(t, 2, dic.Obtener(nueva(base, "b")))
+	require.EqualValues(t, 1, dic.Borrar(nueva(base, "a")))
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Obtener(nueva(base, "a")) })
+
+	datos := []int{}
+	dic.Iterar(func(_ clave, dato int) bool {
+		datos = append(datos, dato)
+		return true
+	})
+	require.EqualValues(t, []int{2, 3}, datos)
+}
+
+func TestABBCodificadoClaveInvalida(t *testing.T) {
+	t.Log("Una clave que el codificador rechaza produce un pánico con su error")
+	dic := TDADiccionario.CrearABBCodificado[float64, int](
+		codificacion.Flotante(codificacion.NaNInvalido, codificacion.CeroNegativoIgual))
+	require.PanicsWithValue(t, codificacion.ErrNaN, func() { dic.Guardar(math.NaN(), 1) })
+	dic.Guardar(math.Copysign(0, -1), 1)
+	require.True(t, dic.Pertenece(0))
+	require.EqualValues(t, 1, dic.Cantidad())
+}
+
+func TestABBCodificadoTuplaConExtremos(t *testing.T) {
+	t.Log("Las tuplas cuyo segundo componente empieza con 0xFF se ordenan y decodifican correctamente")
+	type clave = codificacion.Par[string, int64]
+	dic := TDADiccionario.CrearABBCodificado[clave, int](
+		codificacion.CodificadorPar(codificacion.Cadena(), codificacion.Entero[int64]()))
+	esperadas := []clave{
+		{Primero: "x", Segundo: 0},
+		{Primero: "x", Segundo: math.MaxInt64},
+		{Primero: "x\x00", Segundo: math.MinInt64},
+		{Primero: "x\x00", Segundo: math.MaxInt64},
+	}
+	for i := len(esperadas) - 1; i >= 0; i-- {
+		dic.Guardar(esperadas[i], i)
+	}
+	encontradas := []clave{}
+	dic.Iterar(func(c clave, dato int) bool {
+		require.EqualValues(t, len(encontradas), dato)
+		encontradas = append(encontradas, c)
+		return true
+	})
+	require.EqualValues(t, esperadas, encontradas)
+}
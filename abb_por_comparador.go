@@ -0,0 +1,132 @@
+package diccionario
+
+// DiccionarioPorComparador tiene las mismas primitivas que DiccionarioOrdenado, pero sus claves pueden ser de
+// cualquier tipo (por ejemplo []byte, o estructuras que contienen slices o maps) ya que sólo se comparan con la
+// función de comparación, nunca con ==
+type DiccionarioPorComparador[K any, V any] interface {
+	// Guardar guarda el par clave-dato en el diccionario. Si la clave ya se encontraba, se actualiza el dato
+	// asociado
+	Guardar(clave K, dato V)
+
+	// Pertenece determina si una clave ya se encuentra en el diccionario, o no
+	Pertenece(clave K) bool
+
+	// Obtener devuelve el dato asociado a una clave. Si la clave no pertenece, debe entrar en pánico con mensaje
+	// 'La clave no pertenece al diccionario'
+	Obtener(clave K) V
+
+	// Borrar borra del diccionario la clave indicada, devolviendo el dato que se encontraba asociado. Si la clave no
+	// pertenece al diccionario, debe entrar en pánico con un mensaje 'La clave no pertenece al diccionario'
+	Borrar(clave K) V
+
+	// Cantidad devuelve la cantidad de elementos dentro del diccionario
+	Cantidad() int
+
+	// Iterar itera internamente el diccionario en orden, aplicando la función pasada por parámetro a todos los
+	// elementos del mismo, mientras devuelva true
+	Iterar(visitar func(clave K, dato V) bool)
+
+	// Iterador devuelve un IterPorComparador que recorre el diccionario en orden
+	Iterador() IterPorComparador[K, V]
+
+	// IterarRango itera sólo incluyendo a los elementos que se encuentren comprendidos en el rango indicado,
+	// incluyéndolos en caso de encontrarse
+	IterarRango(desde *K, hasta *K, visitar func(clave K, dato V) bool)
+
+	// IteradorRango crea un IterPorComparador que sólo itere por las claves que se encuentren en el rango indicado
+	IteradorRango(desde *K, hasta *K) IterPorComparador[K, V]
+}
+
+// IterPorComparador es el iterador externo de un DiccionarioPorComparador, con las mismas primitivas que
+// IterDiccionario
+type IterPorComparador[K any, V any] interface {
+	// HaySiguiente devuelve si hay más datos para ver
+	HaySiguiente() bool
+
+	// VerActual devuelve la clave y el dato del elemento actual en el que se encuentra posicionado el iterador.
+	// Si no HaySiguiente, debe entrar en pánico con el mensaje 'El iterador termino de iterar'
+	VerActual() (K, V)
+
+	// Siguiente si HaySiguiente avanza al siguiente elemento en el diccionario. Si no HaySiguiente, entonces debe
+	// entrar en pánico con mensaje 'El iterador termino de iterar'
+	Siguiente()
+}
+
+// abbPorComparador guarda cada clave detrás de un puntero, que siempre es comparable, en un abb cuya función de
+// comparación compara los valores apuntados. Como el abb nunca compara claves con ==, la identidad de los
+// punteros no importa
+type abbPorComparador[K any, V any] struct {
+	arbol *abb[*K, V]
+}
+
+// CrearABBPorComparador crea un DiccionarioPorComparador implementado con un abb. Las claves se guardan por
+// copia, pero una copia de un slice comparte su contenido: modificar una clave ya guardada rompe el orden del
+// árbol
+func CrearABBPorComparador[K any, V any](cmp func(K, K) int) DiccionarioPorComparador[K, V] {
+	compararPunteros := func(a, b *K) int {
+		return cmp(*a, *b)
+	}
+	return &abbPorComparador[K, V]{arbol: &abb[*K, V]{cmp: compararPunteros}}
+}
+
+func (a *abbPorComparador[K, V]) Guardar(clave K, dato V) {
+	a.arbol.Guardar(&clave, dato)
+}
+
+func (a *abbPorComparador[K, V]) Pertenece(clave K) bool {
+	return a.arbol.Pertenece(&clave)
+}
+
+func (a *abbPorComparador[K, V]) Obtener(clave K) V {
+	return a.arbol.Obtener(&clave)
+}
+
+func (a *abbPorComparador[K, V]) Borrar(clave K) V {
+	return a.arbol.Borrar(&clave)
+}
+
+func (a *abbPorComparador[K, V]) Cantidad() int {
+	return a.arbol.Cantidad()
+}
+
+func (a *abbPorComparador[K, V]) Iterar(visitar func(K, V) bool) {
+	a.arbol.Iterar(func(clave *K, dato V) bool { return visitar(*clave, dato) })
+}
+
+func (a *abbPorComparador[K, V]) IterarRango(desde *K, hasta *K, visitar func(K, V) bool) {
+	a.arbol.IterarRango(limitePorComparador(desde), limitePorComparador(hasta), func(clave *K, dato V) bool { return visitar(*clave, dato) })
+}
+
+// limitePorComparador convierte un límite de rango al tipo de clave del abb interno, conservando el nil
+func limitePorComparador[K any](limite *K) **K {
+	if limite == nil {
+		return nil
+	}
+	return &limite
+}
+
+// iteradorPorComparador adapta el iterador del abb desreferenciando las claves
+type iteradorPorComparador[K any, V any] struct {
+	iter IterDiccionario[*K, V]
+}
+
+func (a *abbPorComparador[K, V]) Iterador() IterPorComparador[K, V] {
+	return &iteradorPorComparador[K, V]{iter: a.arbol.Iterador()}
+}
+
+func (a *abbPorComparador[K, V]) IteradorRango(desde *K, hasta *K) IterPorComparador[K, V] {
+	return &iteradorPorComparador[K, V]{iter: a.arbol.IteradorRango(limitePorComparador(desde), limitePorComparador(hasta))}
+}
+
+func (iter *iteradorPorComparador[K, V]) HaySiguiente() bool {
+	return iter.iter.HaySiguiente()
+}
+
+func (iter *iteradorPorComparador[K, V]) VerActual() (K, V) {
+	clave, dato := iter.iter.VerActual()
+	return *clave, dato
+}
+
+func (iter *iteradorPorComparador[K, V]) Siguiente() {
+	iter.iter.Siguiente()
+}
@@ -0,0 +1,92 @@
+package diccionario_test
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	TDADiccionario "tdas/diccionario"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestABBPorComparadorBytes(t *testing.T) {
+	t.Log("Un diccionario con claves []byte funciona comparando sólo con bytes.Compare")
+	dic := TDADiccionario.CrearABBPorComparador[[]byte, int](bytes.Compare)
+	require.EqualValues(t, 0, dic.Cantidad())
+	require.False(t, dic.Pertenece([]byte("a")))
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Obtener([]byte("a")) })
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar(nil) })
+
+	for i, clave := range []string{"gato", "perro", "vaca", "", "pajaro", "cerdo"} {
+		dic.Guardar([]byte(clave), i)
+	}
+	dic.Guardar([]byte("gato"), 10)
+	require.EqualValues(t, 6, dic.Cantidad())
+	require.True(t, dic.Pertenece([]byte("perro")))
+	require.EqualValues(t, 10, dic.Obtener([]byte("gato")))
+	require.EqualValues(t, 3, dic.Obtener(nil))
+	require.EqualValues(t, 2, dic.Borrar([]byte("vaca")))
+	require.False(t, dic.Pertenece([]byte("vaca")))
+
+	claves := []string{}
+	dic.Iterar(func(clave []byte, _ int) bool {
+		claves = append(claves, string(clave))
+		return true
+	})
+	require.EqualValues(t, []string{"", "cerdo", "gato", "pajaro", "perro"}, claves)
+}
+
+func TestABBPorComparadorRangos(t *testing.T) {
+	t.Log("Los iteradores de rango funcionan igual que en un ABB, con límites nil o no")
+	dic := TDADiccionario.CrearABBPorComparador[[]byte, string](bytes.Compare)
+	for _, clave := range []string{"a", "b", "c", "d", "e"} {
+		dic.Guardar([]byte(clave), strings.ToUpper(clave))
+	}
+	desde, hasta := []byte("b"), []byte("d")
+
+	internas := []string{}
+	dic.IterarRango(&desde, &hasta, func(clave []byte, dato string) bool {
+		internas = append(internas, string(clave)+dato)
+		return true
+	})
+	require.EqualValues(t, []string{"bB", "cC", "dD"}, internas)
+
+	externas := []string{}
+	for iter := dic.IteradorRango(nil, &desde); iter.HaySiguiente(); iter.Siguiente() {
+		clave, _ := iter.VerActual()
+		externas = append(externas, string(clave))
+	}
+	require.EqualValues(t, []string{"a", "b"}, externas)
+
+	iter := dic.IteradorRango(&hasta, nil)
+	clave, dato := iter.VerActual()
+	require.EqualValues(t, []byte("d"), clave)
+	require.EqualValues(t, "D", dato)
+	iter.Siguiente()
+	iter.Siguiente()
+	require.False(t, iter.HaySiguiente())
+	require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.Siguiente() })
+	require.True(t, dic.Iterador().HaySiguiente())
+}
+
+func TestABBPorComparadorStructsConSlices(t *testing.T) {
+	t.Log("Las claves pueden ser estructuras con slices, que no son comparables con ==")
+	type ruta struct {
+		partes []string
+	}
+	dic := TDADiccionario.CrearABBPorComparador[ruta, int](func(a, b ruta) int {
+		return slices.Compare(a.partes, b.partes)
+	})
+	dic.Guardar(ruta{[]string{"usr", "bin"}}, 1)
+	dic.Guardar(ruta{[]string{"usr"}}, 2)
+	dic.Guardar(ruta{[]string{"etc"}}, 3)
+	require.EqualValues(t, 1, dic.Obtener(ruta{[]string{"usr", "bin"}}))
+
+	orden := []int{}
+	dic.Iterar(func(_ ruta, dato int) bool {
+		orden = append(orden, dato)
+		return true
+	})
+	require.EqualValues(t, []int{3, 2, 1}, orden)
+}
@@ -0,0 +1,363 @@
+package diccionario
+
+import (
+	"sort"
+
+	TDAPila "tdas/pila"
+)
+
+// nodoB guarda sus claves y datos en slices contiguos. En los nodos internos, hijos tiene una posición más que
+// claves y el hijo i contiene a las claves comprendidas entre claves[i-1] y claves[i]
+type nodoB[K comparable, V any] struct {
+	claves []K
+	datos  []V
+	hijos  []*nodoB[K, V]
+}
+
+type arbolB[K comparable, V any] struct {
+	raiz     *nodoB[K, V]
+	cantidad int
+	cmp      func(K, K) int
+	grado    int
+}
+
+// CrearArbolB crea un árbol B en memoria con el grado mínimo indicado: todo nodo salvo la raíz tiene entre
+// grado-1 y 2*grado-1 claves. Grados más altos reducen la altura y las asignaciones de memoria, a costa de
+// mover más elementos en cada inserción o borrado
+func CrearArbolB[K comparable, V any](cmp func(K, K) int, grado int) DiccionarioOrdenado[K, V] {
+	if grado < 2 {
+		panic("El grado del arbol B debe ser al menos 2")
+	}
+	return &arbolB[K, V]{
+		raiz:     &nodoB[K, V]{},
+		cantidad: 0,
+		cmp:      cmp,
+		grado:    grado,
+	}
+}
+
+func (n *nodoB[K, V]) esHoja() bool {
+	return len(n.hijos) == 0
+}
+
+// buscarPosicion devuelve la posición de la primera clave del nodo mayor o igual a la indicada, y si es igual
+func (a *arbolB[K, V]) buscarPosicion(n *nodoB[K, V], clave K) (int, bool) {
+	pos := sort.Search(len(n.claves), func(i int) bool { return a.cmp(n.claves[i], clave) >= 0 })
+	return pos, pos < len(n.claves) && a.cmp(n.claves[pos], clave) == 0
+}
+
+func (a *arbolB[K, V]) Guardar(clave K, dato V) {
+	if len(a.raiz.claves) == 2*a.grado-1 {
+		raiz := &nodoB[K, V]{hijos: []*nodoB[K, V]{a.raiz}}
+		a.dividirHijo(raiz, 0)
+		a.raiz = raiz
+	}
+	a.guardarNoLleno(a.raiz, clave, dato)
+}
+
+// guardarNoLleno guarda la clave en el subárbol de un nodo que no está lleno, dividiendo de antemano los hijos
+// llenos por los que se desciende
+func (a *arbolB[K, V]) guardarNoLleno(n *nodoB[K, V], clave K, dato V) {
+	for {
+		pos, encontrada := a.buscarPosicion(n, clave)
+		if encontrada {
+			n.datos[pos] = dato
+			return
+		}
+		if n.esHoja() {
+			n.claves = insertarEn(n.claves, pos, clave)
+			n.datos = insertarEn(n.datos, pos, dato)
+			a.cantidad++
+			return
+		}
+		if len(n.hijos[pos].claves) == 2*a.grado-1 {
+			a.dividirHijo(n, pos)
+			if cmp := a.cmp(clave, n.claves[pos]); cmp == 0 {
+				n.datos[pos] = dato
+				return
+			} else if cmp > 0 {
+				pos++
+			}
+		}
+		n = n.hijos[pos]
+	}
+}
+
+// dividirHijo parte al hijo lleno en la posición indicada, subiendo su clave del medio al padre
+func (a *arbolB[K, V]) dividirHijo(padre *nodoB[K, V], pos int) {
+	hijo := padre.hijos[pos]
+	medio := a.grado - 1
+	nuevo := &nodoB[K, V]{
+		claves: append(make([]K, 0, 2*a.grado-1), hijo.claves[medio+1:]...),
+		datos:  append(make([]V, 0, 2*a.grado-1), hijo.datos[medio+1:]...),
+	}
+	if !hijo.esHoja() {
+		nuevo.hijos = append(make([]*nodoB[K, V], 0, 2*a.grado), hijo.hijos[medio+1:]...)
+		clear(hijo.hijos[medio+1:])
+		hijo.hijos = hijo.hijos[:medio+1]
+	}
+	padre.claves = insertarEn(padre.claves, pos, hijo.claves[medio])
+	padre.datos = insertarEn(padre.datos, pos, hijo.datos[medio])
+	padre.hijos = insertarEn(padre.hijos, pos+1, nuevo)
+	clear(hijo.claves[medio:])
+	clear(hijo.datos[medio:])
+	hijo.claves = hijo.claves[:medio]
+	hijo.datos = hijo.datos[:medio]
+}
+
+func (a *arbolB[K, V]) Pertenece(clave K) bool {
+	_, _, ok := a.buscar(clave)
+	return ok
+}
+
+func (a *arbolB[K, V]) Obtener(clave K) V {
+	n, pos, ok := a.buscar(clave)
+	if !ok {
+		panic("La clave no pertenece al diccionario")
+	}
+	return n.datos[pos]
+}
+
+func (a *arbolB[K, V]) buscar(clave K) (*nodoB[K, V], int, bool) {
+	n := a.raiz
+	for {
+		pos, encontrada := a.buscarPosicion(n, clave)
+		if encontrada {
+			return n, pos, true
+		}
+		if n.esHoja() {
+			return nil, 0, false
+		}
+		n = n.hijos[pos]
+	}
+}
+
+func (a *arbolB[K, V]) Borrar(clave K) V {
+	borrado, ok := a.borrarRec(a.raiz, clave)
+	// Aunque la clave no pertenezca, el descenso pudo fusionar a los únicos hijos de la raíz
+	if len(a.raiz.claves) == 0 && !a.raiz.esHoja() {
+		a.raiz = a.raiz.hijos[0]
+	}
+	if !ok {
+		panic("La clave no pertenece al diccionario")
+	}
+	a.cantidad--
+	return borrado
+}
+
+// borrarRec borra la clave del subárbol e indica si pertenecía. Antes de descender a un hijo se asegura de que
+// tenga al menos grado claves, para que el borrado nunca lo deje por debajo del mínimo; si la clave no
+// pertenece, el árbol puede quedar reacomodado pero sigue siendo válido
+func (a *arbolB[K, V]) borrarRec(n *nodoB[K, V], clave K) (V, bool) {
+	pos, encontrada := a.buscarPosicion(n, clave)
+	if n.esHoja() {
+		if !encontrada {
+			var cero V
+			return cero, false
+		}
+		borrado := n.datos[pos]
+		n.claves = quitarDe(n.claves, pos)
+		n.datos = quitarDe(n.datos, pos)
+		return borrado, true
+	}
+
+	if encontrada {
+		borrado := n.datos[pos]
+		izq, der := n.hijos[pos], n.hijos[pos+1]
+		if len(izq.claves) >= a.grado {
+			// Reemplazar por el predecesor
+			pred := izq
+			for !pred.esHoja() {
+				pred = pred.hijos[len(pred.hijos)-1]
+			}
+			n.claves[pos] = pred.claves[len(pred.claves)-1]
+			n.datos[pos], _ = a.borrarRec(izq, n.claves[pos])
+		} else if len(der.claves) >= a.grado {
+			// Reemplazar por el sucesor
+			suc := der
+			for !suc.esHoja() {
+				suc = suc.hijos[0]
+			}
+			n.claves[pos] = suc.claves[0]
+			n.datos[pos], _ = a.borrarRec(der, n.claves[pos])
+		} else {
+			a.fusionarHijos(n, pos)
+			a.borrarRec(izq, clave)
+		}
+		return borrado, true
+	}
+
+	if len(n.hijos[pos].claves) < a.grado {
+		pos = a.completarHijo(n, pos)
+	}
+	return a.borrarRec(n.hijos[pos], clave)
+}
+
+// completarHijo lleva al hijo en la posición indicada a tener al menos grado claves, pidiendo una clave a un
+// hermano o fusionándolo con él. Devuelve la posición en la que quedó el hijo
+func (a *arbolB[K, V]) completarHijo(n *nodoB[K, V], pos int) int {
+	hijo := n.hijos[pos]
+	if pos > 0 && len(n.hijos[pos-1].claves) >= a.grado {
+		izq := n.hijos[pos-1]
+		ultima := len(izq.claves) - 1
+		hijo.claves = insertarEn(hijo.claves, 0, n.claves[pos-1])
+		hijo.datos = insertarEn(hijo.datos, 0, n.datos[pos-1])
+		n.claves[pos-1], n.datos[pos-1] = izq.claves[ultima], izq.datos[ultima]
+		izq.claves = quitarDe(izq.claves, ultima)
+		izq.datos = quitarDe(izq.datos, ultima)
+		if !izq.esHoja() {
+			hijo.hijos = insertarEn(hijo.hijos, 0, izq.hijos[len(izq.hijos)-1])
+			izq.hijos = quitarDe(izq.hijos, len(izq.hijos)-1)
+		}
+		return pos
+	}
+	if pos < len(n.hijos)-1 && len(n.hijos[pos+1].claves) >= a.grado {
+		der := n.hijos[pos+1]
+		hijo.claves = append(hijo.claves, n.claves[pos])
+		hijo.datos = append(hijo.datos, n.datos[pos])
+		n.claves[pos], n.datos[pos] = der.claves[0], der.datos[0]
+		der.claves = quitarDe(der.claves, 0)
+		der.datos = quitarDe(der.datos, 0)
+		if !der.esHoja() {
+			hijo.hijos = append(hijo.hijos, der.hijos[0])
+			der.hijos = quitarDe(der.hijos, 0)
+		}
+		return pos
+	}
+	if pos == len(n.hijos)-1 {
+		pos--
+	}
+	a.fusionarHijos(n, pos)
+	return pos
+}
+
+// fusionarHijos une al hijo pos+1 y a la clave pos del padre dentro del hijo pos
+func (a *arbolB[K, V]) fusionarHijos(n *nodoB[K, V], pos int) {
+	izq, der := n.hijos[pos], n.hijos[pos+1]
+	izq.claves = append(append(izq.claves, n.claves[pos]), der.claves...)
+	izq.datos = append(append(izq.datos, n.datos[pos]), der.datos...)
+	izq.hijos = append(izq.hijos, der.hijos...)
+	n.claves = quitarDe(n.claves, pos)
+	n.datos = quitarDe(n.datos, pos)
+	n.hijos = quitarDe(n.hijos, pos+1)
+}
+
+func (a *arbolB[K, V]) Cantidad() int {
+	return a.cantidad
+}
+
+func (a *arbolB[K, V]) Iterar(visitar func(K, V) bool) {
+	a.iterarRango(a.raiz, nil, nil, visitar)
+}
+
+func (a *arbolB[K, V]) IterarRango(desde *K, hasta *K, visitar func(K, V) bool) {
+	a.iterarRango(a.raiz, desde, hasta, visitar)
+}
+
+// iterarRango devuelve false si hay que cortar la iteración, ya sea porque visitar lo indicó o porque se
+// superó el límite superior
+func (a *arbolB[K, V]) iterarRango(n *nodoB[K, V], desde *K, hasta *K, visitar func(K, V) bool) bool {
+	pos := 0
+	if desde != nil {
+		pos, _ = a.buscarPosicion(n, *desde)
+	}
+	for ; pos <= len(n.claves); pos++ {
+		if !n.esHoja() && !a.iterarRango(n.hijos[pos], desde, hasta, visitar) {
+			return false
+		}
+		if pos == len(n.claves) {
+			break
+		}
+		if hasta != nil && a.cmp(n.claves[pos], *hasta) > 0 {
+			return false
+		}
+		if !visitar(n.claves[pos], n.datos[pos]) {
+			return false
+		}
+	}
+	return true
+}
+
+// marcoIterB es una posición dentro de un nodo: la próxima clave a visitar del nodo es claves[pos]
+type marcoIterB[K comparable, V any] struct {
+	nodo *nodoB[K, V]
+	pos  int
+}
+
+type iteradorB[K comparable, V any] struct {
+	pila  TDAPila.Pila[*marcoIterB[K, V]]
+	cmp   func(K, K) int
+	hasta *K
+}
+
+func (a *arbolB[K, V]) Iterador() IterDiccionario[K, V] {
+	return a.IteradorRango(nil, nil)
+}
+
+func (a *arbolB[K, V]) IteradorRango(desde *K, hasta *K) IterDiccionario[K, V] {
+	iter := &iteradorB[K, V]{pila: TDAPila.CrearPilaDinamica[*marcoIterB[K, V]](), cmp: a.cmp, hasta: hasta}
+	for n := a.raiz; n != nil; {
+		pos := 0
+		if desde != nil {
+			pos, _ = a.buscarPosicion(n, *desde)
+		}
+		iter.pila.Apilar(&marcoIterB[K, V]{nodo: n, pos: pos})
+		if n.esHoja() {
+			n = nil
+		} else {
+			n = n.hijos[pos]
+		}
+	}
+	iter.descartarTerminados()
+	return iter
+}
+
+// apilarIzquierdos apila el camino hacia la clave más chica del subárbol
+func (iter *iteradorB[K, V]) apilarIzquierdos(n *nodoB[K, V]) {
+	for {
+		iter.pila.Apilar(&marcoIterB[K, V]{nodo: n})
+		if n.esHoja() {
+			return
+		}
+		n = n.hijos[0]
+	}
+}
+
+// descartarTerminados desapila los nodos cuyas claves ya fueron todas visitadas
+func (iter *iteradorB[K, V]) descartarTerminados() {
+	for !iter.pila.EstaVacia() {
+		marco := iter.pila.VerTope()
+		if marco.pos < len(marco.nodo.claves) {
+			return
+		}
+		iter.pila.Desapilar()
+	}
+}
+
+func (iter *iteradorB[K, V]) HaySiguiente() bool {
+	if iter.pila.EstaVacia() {
+		return false
+	}
+	marco := iter.pila.VerTope()
+	return iter.hasta == nil || iter.cmp(marco.nodo.claves[marco.pos], *iter.hasta) <= 0
+}
+
+func (iter *iteradorB[K, V]) VerActual() (K, V) {
+	if !iter.HaySiguiente() {
+		panic("El iterador termino de iterar")
+	}
+	marco := iter.pila.VerTope()
+	return marco.nodo.claves[marco.pos], marco.nodo.datos[marco.pos]
+}
+
+func (iter *iteradorB[K, V]) Siguiente() {
+	if !iter.HaySiguiente() {
+		panic("El iterador termino de iterar")
+	}
+	marco := iter.pila.VerTope()
+	marco.pos++
+	if !marco.nodo.esHoja() {
+		iter.apilarIzquierdos(marco.nodo.hijos[marco.pos])
+	}
+	iter.descartarTerminados()
+}
@@ -0,0 +1,116 @@
+package diccionario_test
+
+import (
+	"cmp"
+	"math/rand"
+	"strings"
+	TDADiccionario "tdas/diccionario"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestArbolBVacio(t *testing.T) {
+	t.Log("Comprueba que un árbol B vacío no tiene claves")
+	dic := TDADiccionario.CrearArbolB[string, string](strings.Compare, 2)
+	require.EqualValues(t, 0, dic.Cantidad())
+	require.False(t, dic.Pertenece("A"))
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Obtener("A") })
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar("A") })
+	require.False(t, dic.Iterador().HaySiguiente())
+}
+
+func TestArbolBGradoInvalido(t *testing.T) {
+	t.Log("El grado mínimo de un árbol B es 2")
+	require.PanicsWithValue(t, "El grado del arbol B debe ser al menos 2", func() {
+		TDADiccionario.CrearArbolB[int, int](cmp.Compare, 1)
+	})
+}
+
+func TestArbolBVolumen(t *testing.T) {
+	t.Log("Guarda, reemplaza y borra muchas claves en orden aleatorio con distintos grados")
+	for _, grado := range []int{2, 3, 16} {
+		dic := TDADiccionario.CrearArbolB[int, int](cmp.Compare, grado)
+		aleatorio := rand.New(rand.NewSource(int64(grado)))
+		claves := aleatorio.Perm(5000)
+		for _, clave := range claves {
+			dic.Guardar(clave, clave)
+		}
+		for _, clave := range claves {
+			dic.Guardar(clave, -clave)
+		}
+		require.EqualValues(t, 5000, dic.Cantidad())
+
+		borradas := aleatorio.Perm(5000)[:4000]
+		for _, clave := range borradas {
+			require.EqualValues(t, -clave, dic.Borrar(clave))
+			require.False(t, dic.Pertenece(clave))
+		}
+		require.EqualValues(t, 1000, dic.Cantidad())
+
+		anterior := -1
+		cantidad := 0
+		dic.Iterar(func(clave int, dato int) bool {
+			require.Greater(t, clave, anterior)
+			require.EqualValues(t, -clave, dato)
+			require.EqualValues(t, dato, dic.Obtener(clave))
+			anterior = clave
+			cantidad++
+			return true
+		})
+		require.EqualValues(t, 1000, cantidad)
+	}
+}
+
+func TestArbolBIteradorRango(t *testing.T) {
+	t.Log("Los iteradores de rango, interno y externo, respetan los límites en un árbol con varios niveles")
+	dic := TDADiccionario.CrearArbolB[int, int](cmp.Compare, 2)
+	for i := 0; i < 200; i += 2 {
+		dic.Guardar(i, i)
+	}
+	desde, hasta := 31, 61
+	esperadas := []int{32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60}
+
+	iter := dic.IteradorRango(&desde, &hasta)
+	claves := []int{}
+	for iter.HaySiguiente() {
+		clave, _ := iter.VerActual()
+		claves = append(claves, clave)
+		iter.Siguiente()
+	}
+	require.EqualValues(t, esperadas, claves)
+	require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.VerActual() })
+
+	claves = []int{}
+	dic.IterarRango(&desde, &hasta, func(clave int, _ int) bool {
+		claves = append(claves, clave)
+		return true
+	})
+	require.EqualValues(t, esperadas, claves)
+
+	claves = []int{}
+	dic.IterarRango(nil, nil, func(clave int, _ int) bool {
+		claves = append(claves, clave)
+		return len(claves) < 3
+	})
+	require.EqualValues(t, []int{0, 2, 4}, claves)
+}
+
+func TestArbolBBorrarInexistente(t *testing.T) {
+	t.Log("Borrar claves que no pertenecen entra en pánico y deja al árbol con las mismas claves")
+	for _, grado := range []int{2, 3} {
+		dic := TDADiccionario.CrearArbolB[int, int](cmp.Compare, grado)
+		for i := 0; i < 2000; i += 2 {
+			dic.Guardar(i, i)
+		}
+		for _, clave := range rand.New(rand.NewSource(int64(grado))).Perm(1000) {
+			require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar(2*clave + 1) })
+		}
+		require.EqualValues(t, 1000, dic.Cantidad())
+		for i := 0; i < 2000; i += 2 {
+			require.EqualValues(t, i, dic.Borrar(i))
+		}
+		require.EqualValues(t, 0, dic.Cantidad())
+		require.False(t, dic.Iterador().HaySiguiente())
+	}
+}
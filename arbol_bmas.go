@@ -0,0 +1,727 @@
+package diccionario
+
+import (
+	"bytes"
+	"container/list"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+// DiccionarioEnDisco es un DiccionarioOrdenado almacenado en un archivo. Los cambios se mantienen en un cache de
+// páginas y se escriben a disco al desalojarse del cache, al Sincronizar o al Cerrar.
+//
+// Como las primitivas de DiccionarioOrdenado no devuelven error, un error de entrada/salida durante ellas
+// produce un pánico con un error que envuelve a ErrAccesoDisco.
+type DiccionarioEnDisco[K comparable, V any] interface {
+	DiccionarioOrdenado[K, V]
+
+	// Sincronizar escribe todas las páginas modificadas y fuerza el archivo a disco
+	Sincronizar() error
+
+	// Cerrar sincroniza y cierra el archivo. Luego de cerrado, el diccionario no debe volver a usarse
+	Cerrar() error
+}
+
+// OpcionesArbolBMas configura un árbol B+ en disco. Los valores en cero toman los valores por defecto
+type OpcionesArbolBMas struct {
+	// TamanioPagina es el tamaño en bytes de cada página del archivo. Sólo se usa al crear el archivo
+	TamanioPagina int
+
+	// PaginasEnCache es la cantidad de páginas que se mantienen en memoria
+	PaginasEnCache int
+}
+
+var (
+	// ErrAccesoDisco es el error envuelto en los pánicos producidos por fallas de lectura o escritura
+	ErrAccesoDisco = errors.New("error de acceso al archivo del diccionario")
+
+	// ErrEntradaDemasiadoGrande indica que una clave o dato no entra en una página
+	ErrEntradaDemasiadoGrande = errors.New("la clave o el dato no entran en una pagina")
+)
+
+const (
+	tamanioPaginaPorDefecto  = 4096
+	paginasEnCachePorDefecto = 64
+	tamanioPaginaMinimo      = 256
+	tamanioPaginaMaximo      = 1 << 24
+
+	// margenEntrada cubre lo que una entrada serializada suelta no cuenta de lo que agrega a una hoja: los largos
+	// de Claves y Datos, que pueden ganar un byte o aparecer junto con su campo, y las claves o datos en cero,
+	// que en un struct se omiten pero en un slice ocupan un byte
+	margenEntrada = 8
+
+	firmaArbolBMas = "ABBBMAS1"
+
+	// Cabecera de cada página: tipo (1 byte) y largo del contenido (4 bytes)
+	cabeceraPagina = 5
+
+	paginaHoja    byte = 1
+	paginaInterna byte = 2
+	paginaLibre   byte = 3
+)
+
+// contenidoPagina es la parte de una página que se serializa con gob
+type contenidoPagina[K comparable, V any] struct {
+	Claves    []K
+	Datos     []V
+	Hijos     []uint64
+	Siguiente uint64
+	Anterior  uint64
+}
+
+// paginaBMas es una página en memoria. En las hojas Claves y Datos tienen el mismo largo y Siguiente/Anterior
+// enlazan las hojas en orden. En las internas, Hijos tiene una posición más que Claves y el hijo i contiene a
+// las claves menores a Claves[i] y mayores o iguales a Claves[i-1]. En una página libre, Siguiente es la
+// próxima página libre
+type paginaBMas[K comparable, V any] struct {
+	id    uint64
+	tipo  byte
+	sucia bool
+
+	// tamanio es una cota superior del largo de la página serializada, o 0 si hay que medirla. Al guardar en
+	// una hoja se le suma lo que agrega la entrada, de forma que sólo hace falta serializar la página entera
+	// cuando la cota supera el tamaño de página
+	tamanio int
+	contenidoPagina[K, V]
+}
+
+// modificar marca la página para escribirla, y descarta la cota de su tamaño
+func (p *paginaBMas[K, V]) modificar() {
+	p.sucia = true
+	p.tamanio = 0
+}
+
+// entradaBMas es un par clave-dato que se serializa suelto para estimar cuánto agrega a una hoja
+type entradaBMas[K comparable, V any] struct {
+	Clave K
+	Dato  V
+}
+
+// divisionBMas es una página nueva que debe insertarse en el padre, a la derecha de la separadora
+type divisionBMas[K comparable] struct {
+	separadora K
+	id         uint64
+}
+
+type arbolBMas[K comparable, V any] struct {
+	archivo       *os.File
+	cmp           func(K, K) int
+	tamanioPagina int
+	capacidad     int
+
+	// cache LRU: el frente de orden es la página usada más recientemente
+	orden   *list.List
+	paginas map[uint64]*list.Element
+
+	raiz          uint64
+	totalPaginas  uint64
+	libres        uint64
+	cantidad      int
+	cabeceraSucia bool
+
+	// tamanioEntradaVacia es el largo de una entradaBMas en cero serializada, o 0 si todavía no se midió
+	tamanioEntradaVacia int
+}
+
+// CrearArbolBMas abre (o crea) un árbol B+ almacenado en el archivo de la ruta indicada. Las claves y los datos
+// se serializan con encoding/gob, por lo que deben ser tipos que gob pueda codificar.
+//
+// Las hojas que quedan vacías se liberan, pero no se fusionan hojas con pocos elementos: el espacio liberado
+// se reutiliza en inserciones posteriores.
+func CrearArbolBMas[K comparable, V any](ruta string, cmp func(K, K) int, opciones OpcionesArbolBMas) (DiccionarioEnDisco[K, V], error) {
+	if opciones.TamanioPagina == 0 {
+		opciones.TamanioPagina = tamanioPaginaPorDefecto
+	}
+	if opciones.PaginasEnCache == 0 {
+		opciones.PaginasEnCache = paginasEnCachePorDefecto
+	}
+	if !tamanioPaginaValido(opciones.TamanioPagina) {
+		return nil, fmt.Errorf("tamaño de pagina invalido: %d", opciones.TamanioPagina)
+	}
+	if opciones.PaginasEnCache < 0 {
+		return nil, fmt.Errorf("cantidad de paginas en cache invalida: %d", opciones.PaginasEnCache)
+	}
+
+	archivo, err := os.OpenFile(ruta, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("abriendo %s: %w", ruta, err)
+	}
+	arbol := &arbolBMas[K, V]{
+		archivo:       archivo,
+		cmp:           cmp,
+		tamanioPagina: opciones.TamanioPagina,
+		capacidad:     opciones.PaginasEnCache,
+		orden:         list.New(),
+		paginas:       make(map[uint64]*list.Element),
+	}
+
+	info, err := archivo.Stat()
+	if err != nil {
+		archivo.Close()
+		return nil, fmt.Errorf("abriendo %s: %w", ruta, err)
+	}
+	if info.Size() == 0 {
+		err = arbol.inicializar()
+	} else {
+		err = arbol.leerCabecera()
+	}
+	if err != nil {
+		archivo.Close()
+		return nil, err
+	}
+	return arbol, nil
+}
+
+func tamanioPaginaValido(tamanio int) bool {
+	return tamanio >= tamanioPaginaMinimo && tamanio <= tamanioPaginaMaximo
+}
+
+// inicializar crea un archivo con la cabecera (página 0) y una hoja vacía como raíz (página 1)
+func (a *arbolBMas[K, V]) inicializar() error {
+	a.totalPaginas = 1
+	a.raiz = a.nuevaPagina(paginaHoja).id
+	a.cabeceraSucia = true
+	return a.Sincronizar()
+}
+
+// La cabecera tiene la firma, el tamaño de página, la raíz, el total de páginas, la primera página libre y la
+// cantidad de elementos
+func (a *arbolBMas[K, V]) leerCabecera() error {
+	cabecera := make([]byte, len(firmaArbolBMas)+4+8*4)
+	if _, err := a.archivo.ReadAt(cabecera, 0); err != nil {
+		return fmt.Errorf("leyendo cabecera: %w", err)
+	}
+	if string(cabecera[:len(firmaArbolBMas)]) != firmaArbolBMas {
+		return errors.New("el archivo no contiene un arbol B+")
+	}
+	datos := cabecera[len(firmaArbolBMas):]
+	// El tamaño de página lo define el archivo, no las opciones
+	a.tamanioPagina = int(binary.LittleEndian.Uint32(datos[0:4]))
+	if !tamanioPaginaValido(a.tamanioPagina) {
+		return fmt.Errorf("cabecera dañada: tamaño de pagina invalido: %d", a.tamanioPagina)
+	}
+	a.raiz = binary.LittleEndian.Uint64(datos[4:12])
+	a.totalPaginas = binary.LittleEndian.Uint64(datos[12:20])
+	a.libres = binary.LittleEndian.Uint64(datos[20:28])
+	a.cantidad = int(binary.LittleEndian.Uint64(datos[28:36]))
+	return nil
+}
+
+func (a *arbolBMas[K, V]) escribirCabecera() error {
+	cabecera := make([]byte, a.tamanioPagina)
+	copy(cabecera, firmaArbolBMas)
+	datos := cabecera[len(firmaArbolBMas):]
+	binary.LittleEndian.PutUint32(datos[0:4], uint32(a.tamanioPagina))
+	binary.LittleEndian.PutUint64(datos[4:12], a.raiz)
+	binary.LittleEndian.PutUint64(datos[12:20], a.totalPaginas)
+	binary.LittleEndian.PutUint64(datos[20:28], a.libres)
+	binary.LittleEndian.PutUint64(datos[28:36], uint64(a.cantidad))
+	if _, err := a.archivo.WriteAt(cabecera, 0); err != nil {
+		return fmt.Errorf("escribiendo cabecera: %w", err)
+	}
+	a.cabeceraSucia = false
+	return nil
+}
+
+// pagina devuelve la página con el id indicado, leyéndola del archivo si no está en el cache. Durante una
+// operación el cache nunca desaloja páginas, por lo que los punteros obtenidos siguen siendo válidos hasta
+// que se llama a ajustarCache
+func (a *arbolBMas[K, V]) pagina(id uint64) *paginaBMas[K, V] {
+	if elem, ok := a.paginas[id]; ok {
+		a.orden.MoveToFront(elem)
+		return elem.Value.(*paginaBMas[K, V])
+	}
+	p, err := a.leerPagina(id)
+	if err != nil {
+		panic(fmt.Errorf("%w: %w", ErrAccesoDisco, err))
+	}
+	a.paginas[id] = a.orden.PushFront(p)
+	return p
+}
+
+func (a *arbolBMas[K, V]) leerPagina(id uint64) (*paginaBMas[K, V], error) {
+	buffer := make([]byte, a.tamanioPagina)
+	if _, err := a.archivo.ReadAt(buffer, int64(id)*int64(a.tamanioPagina)); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("leyendo pagina %d: %w", id, err)
+	}
+	p := &paginaBMas[K, V]{id: id, tipo: buffer[0]}
+	largo := binary.LittleEndian.Uint32(buffer[1:cabeceraPagina])
+	if int(largo) > a.tamanioPagina-cabeceraPagina {
+		return nil, fmt.Errorf("pagina %d dañada", id)
+	}
+	contenido := buffer[cabeceraPagina : cabeceraPagina+largo]
+	switch p.tipo {
+	case paginaLibre:
+		p.Siguiente = binary.LittleEndian.Uint64(contenido)
+	case paginaHoja, paginaInterna:
+		if err := gob.NewDecoder(bytes.NewReader(contenido)).Decode(&p.contenidoPagina); err != nil {
+			return nil, fmt.Errorf("decodificando pagina %d: %w", id, err)
+		}
+	default:
+		return nil, fmt.Errorf("pagina %d de tipo desconocido %d", id, p.tipo)
+	}
+	p.tamanio = cabeceraPagina + int(largo)
+	return p, nil
+}
+
+// codificar devuelve la página serializada, sin completar hasta el tamaño de página
+func (a *arbolBMas[K, V]) codificar(p *paginaBMas[K, V]) ([]byte, error) {
+	var buffer bytes.Buffer
+	buffer.Write(make([]byte, cabeceraPagina))
+	if p.tipo == paginaLibre {
+		var siguiente [8]byte
+		binary.LittleEndian.PutUint64(siguiente[:], p.Siguiente)
+		buffer.Write(siguiente[:])
+	} else if err := gob.NewEncoder(&buffer).Encode(&p.contenidoPagina); err != nil {
+		return nil, err
+	}
+	codificada := buffer.Bytes()
+	codificada[0] = p.tipo
+	binary.LittleEndian.PutUint32(codificada[1:cabeceraPagina], uint32(len(codificada)-cabeceraPagina))
+	return codificada, nil
+}
+
+// excede indica si la página no entra en el tamaño de página. Sólo la serializa si no conoce una cota de su
+// tamaño que entre
+func (a *arbolBMas[K, V]) excede(p *paginaBMas[K, V]) bool {
+	if p.tamanio > 0 && p.tamanio <= a.tamanioPagina {
+		return false
+	}
+	codificada, err := a.codificar(p)
+	if err != nil {
+		panic(fmt.Errorf("%w: %w", ErrAccesoDisco, err))
+	}
+	p.tamanio = len(codificada)
+	return p.tamanio > a.tamanioPagina
+}
+
+// largoEntrada devuelve el largo de la entrada serializada sola, con un encoder nuevo
+func largoEntrada[K comparable, V any](entrada entradaBMas[K, V]) int {
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(&entrada); err != nil {
+		panic(fmt.Errorf("%w: %w", ErrAccesoDisco, err))
+	}
+	return buffer.Len()
+}
+
+// costoEntrada devuelve una cota de cuánto agrega el par a una hoja serializada. A la entrada serializada se le
+// resta una en cero, que tiene la misma descripción de tipos que gob escribe una sola vez por página
+func (a *arbolBMas[K, V]) costoEntrada(clave K, dato V) int {
+	if a.tamanioEntradaVacia == 0 {
+		a.tamanioEntradaVacia = largoEntrada(entradaBMas[K, V]{})
+	}
+	return largoEntrada(entradaBMas[K, V]{Clave: clave, Dato: dato}) - a.tamanioEntradaVacia + margenEntrada
+}
+
+func (a *arbolBMas[K, V]) escribirPagina(p *paginaBMas[K, V]) error {
+	codificada, err := a.codificar(p)
+	if err != nil {
+		return fmt.Errorf("codificando pagina %d: %w", p.id, err)
+	}
+	// validarTamanio sólo acota la entrada nueva: si la página serializada igual no entra, escribirla truncada
+	// dañaría el archivo
+	if len(codificada) > a.tamanioPagina {
+		return fmt.Errorf("pagina %d: %w", p.id, ErrEntradaDemasiadoGrande)
+	}
+	buffer := make([]byte, a.tamanioPagina)
+	copy(buffer, codificada)
+	if _, err := a.archivo.WriteAt(buffer, int64(p.id)*int64(a.tamanioPagina)); err != nil {
+		return fmt.Errorf("escribiendo pagina %d: %w", p.id, err)
+	}
+	p.sucia = false
+	p.tamanio = len(codificada)
+	return nil
+}
+
+// ajustarCache desaloja las páginas usadas menos recientemente hasta respetar la capacidad del cache,
+// escribiendo las que fueron modificadas
+func (a *arbolBMas[K, V]) ajustarCache() {
+	for a.orden.Len() > a.capacidad {
+		elem := a.orden.Back()
+		p := elem.Value.(*paginaBMas[K, V])
+		if p.sucia {
+			if err := a.escribirPagina(p); err != nil {
+				panic(fmt.Errorf("%w: %w", ErrAccesoDisco, err))
+			}
+		}
+		a.orden.Remove(elem)
+		delete(a.paginas, p.id)
+	}
+}
+
+// nuevaPagina reutiliza una página libre o agrega una al final del archivo
+func (a *arbolBMas[K, V]) nuevaPagina(tipo byte) *paginaBMas[K, V] {
+	var p *paginaBMas[K, V]
+	if a.libres != 0 {
+		p = a.pagina(a.libres)
+		a.libres = p.Siguiente
+		p.contenidoPagina = contenidoPagina[K, V]{}
+	} else {
+		p = &paginaBMas[K, V]{id: a.totalPaginas}
+		a.totalPaginas++
+		a.paginas[p.id] = a.orden.PushFront(p)
+	}
+	p.tipo = tipo
+	p.modificar()
+	a.cabeceraSucia = true
+	return p
+}
+
+func (a *arbolBMas[K, V]) liberarPagina(p *paginaBMas[K, V]) {
+	p.tipo = paginaLibre
+	p.contenidoPagina = contenidoPagina[K, V]{Siguiente: a.libres}
+	p.modificar()
+	a.libres = p.id
+	a.cabeceraSucia = true
+}
+
+// posicionHijo devuelve el índice del hijo de una página interna en el que debe estar la clave
+func (a *arbolBMas[K, V]) posicionHijo(p *paginaBMas[K, V], clave K) int {
+	return sort.Search(len(p.Claves), func(i int) bool { return a.cmp(p.Claves[i], clave) > 0 })
+}
+
+// posicionHoja devuelve la posición de la primera clave de la hoja mayor o igual a la indicada
+func (a *arbolBMas[K, V]) posicionHoja(p *paginaBMas[K, V], clave K) int {
+	return sort.Search(len(p.Claves), func(i int) bool { return a.cmp(p.Claves[i], clave) >= 0 })
+}
+
+// buscarHoja devuelve la hoja en la que debe estar la clave
+func (a *arbolBMas[K, V]) buscarHoja(clave K) *paginaBMas[K, V] {
+	p := a.pagina(a.raiz)
+	for p.tipo == paginaInterna {
+		p = a.pagina(p.Hijos[a.posicionHijo(p, clave)])
+	}
+	return p
+}
+
+// primeraHoja devuelve la hoja con las claves más chicas
+func (a *arbolBMas[K, V]) primeraHoja() *paginaBMas[K, V] {
+	p := a.pagina(a.raiz)
+	for p.tipo == paginaInterna {
+		p = a.pagina(p.Hijos[0])
+	}
+	return p
+}
+
+// validarTamanio verifica, antes de modificar el árbol, que una hoja con dos entradas como la indicada y una
+// página interna con tres copias de la clave entren en una página. Así toda página que se exceda puede
+// dividirse en páginas que entren
+func (a *arbolBMas[K, V]) validarTamanio(clave K, dato V) {
+	hoja := &paginaBMas[K, V]{tipo: paginaHoja}
+	hoja.Claves = []K{clave, clave}
+	hoja.Datos = []V{dato, dato}
+	interna := &paginaBMas[K, V]{tipo: paginaInterna}
+	interna.Claves = []K{clave, clave, clave}
+	interna.Hijos = []uint64{a.totalPaginas, a.totalPaginas, a.totalPaginas, a.totalPaginas}
+	if a.excede(hoja) || a.excede(interna) {
+		panic(ErrEntradaDemasiadoGrande)
+	}
+}
+
+func (a *arbolBMas[K, V]) Guardar(clave K, dato V) {
+	defer a.ajustarCache()
+	a.validarTamanio(clave, dato)
+	divisiones := a.guardarRec(a.pagina(a.raiz), clave, dato, a.costoEntrada(clave, dato))
+	for len(divisiones) > 0 {
+		// La raíz se dividió: se crea una nueva raíz interna por encima
+		vieja := a.raiz
+		raiz := a.nuevaPagina(paginaInterna)
+		raiz.Hijos = []uint64{vieja}
+		for _, division := range divisiones {
+			raiz.Claves = append(raiz.Claves, division.separadora)
+			raiz.Hijos = append(raiz.Hijos, division.id)
+		}
+		a.raiz = raiz.id
+		divisiones = a.dividirSiExcede(raiz)
+	}
+}
+
+func (a *arbolBMas[K, V]) guardarRec(p *paginaBMas[K, V], clave K, dato V, costo int) []divisionBMas[K] {
+	if p.tipo == paginaHoja {
+		pos := a.posicionHoja(p, clave)
+		// Reemplazar un dato también suma el costo entero, ya que no se sabe cuánto ocupaba el anterior
+		cota := p.tamanio
+		p.modificar()
+		if cota > 0 {
+			p.tamanio = cota + costo
+		}
+		if pos < len(p.Claves) && a.cmp(p.Claves[pos], clave) == 0 {
+			p.Datos[pos] = dato
+		} else {
+			p.Claves = insertarEn(p.Claves, pos, clave)
+			p.Datos = insertarEn(p.Datos, pos, dato)
+			a.cantidad++
+			a.cabeceraSucia = true
+		}
+		return a.dividirSiExcede(p)
+	}
+
+	pos := a.posicionHijo(p, clave)
+	divisiones := a.guardarRec(a.pagina(p.Hijos[pos]), clave, dato, costo)
+	if len(divisiones) == 0 {
+		return nil
+	}
+	for i, division := range divisiones {
+		p.Claves = insertarEn(p.Claves, pos+i, division.separadora)
+		p.Hijos = insertarEn(p.Hijos, pos+i+1, division.id)
+	}
+	p.modificar()
+	return a.dividirSiExcede(p)
+}
+
+// dividirSiExcede parte la página a la mitad tantas veces como sea necesario para que cada parte entre en una
+// página, y devuelve las páginas nuevas que hay que agregar al padre
+func (a *arbolBMas[K, V]) dividirSiExcede(p *paginaBMas[K, V]) []divisionBMas[K] {
+	if !a.excede(p) {
+		return nil
+	}
+	nueva := a.nuevaPagina(p.tipo)
+	medio := len(p.Claves) / 2
+	var separadora K
+	if p.tipo == paginaHoja {
+		separadora = p.Claves[medio]
+		nueva.Claves = append([]K(nil), p.Claves[medio:]...)
+		nueva.Datos = append([]V(nil), p.Datos[medio:]...)
+		p.Claves = p.Claves[:medio:medio]
+		p.Datos = p.Datos[:medio:medio]
+
+		nueva.Anterior = p.id
+		nueva.Siguiente = p.Siguiente
+		if p.Siguiente != 0 {
+			siguiente := a.pagina(p.Siguiente)
+			siguiente.Anterior = nueva.id
+			siguiente.modificar()
+		}
+		p.Siguiente = nueva.id
+	} else {
+		// En las internas la clave del medio sube al padre
+		separadora = p.Claves[medio]
+		nueva.Claves = append([]K(nil), p.Claves[medio+1:]...)
+		nueva.Hijos = append([]uint64(nil), p.Hijos[medio+1:]...)
+		p.Claves = p.Claves[:medio:medio]
+		p.Hijos = p.Hijos[: medio+1 : medio+1]
+	}
+	p.modificar()
+
+	divisiones := a.dividirSiExcede(p)
+	divisiones = append(divisiones, divisionBMas[K]{separadora: separadora, id: nueva.id})
+	return append(divisiones, a.dividirSiExcede(nueva)...)
+}
+
+func insertarEn[T any](elementos []T, pos int, elem T) []T {
+	var cero T
+	elementos = append(elementos, cero)
+	copy(elementos[pos+1:], elementos[pos:])
+	elementos[pos] = elem
+	return elementos
+}
+
+func quitarDe[T any](elementos []T, pos int) []T {
+	return append(elementos[:pos], elementos[pos+1:]...)
+}
+
+func (a *arbolBMas[K, V]) Pertenece(clave K) bool {
+	defer a.ajustarCache()
+	hoja := a.buscarHoja(clave)
+	pos := a.posicionHoja(hoja, clave)
+	return pos < len(hoja.Claves) && a.cmp(hoja.Claves[pos], clave) == 0
+}
+
+func (a *arbolBMas[K, V]) Obtener(clave K) V {
+	defer a.ajustarCache()
+	hoja := a.buscarHoja(clave)
+	pos := a.posicionHoja(hoja, clave)
+	if pos == len(hoja.Claves) || a.cmp(hoja.Claves[pos], clave) != 0 {
+		panic("La clave no pertenece al diccionario")
+	}
+	return hoja.Datos[pos]
+}
+
+func (a *arbolBMas[K, V]) Borrar(clave K) V {
+	defer a.ajustarCache()
+	borrado, ok, _ := a.borrarRec(a.pagina(a.raiz), clave, true)
+	if !ok {
+		panic("La clave no pertenece al diccionario")
+	}
+	a.cantidad--
+	a.cabeceraSucia = true
+
+	// Si la raíz quedó con un único hijo, ese hijo pasa a ser la raíz
+	for raiz := a.pagina(a.raiz); raiz.tipo == paginaInterna && len(raiz.Hijos) == 1; raiz = a.pagina(a.raiz) {
+		a.raiz = raiz.Hijos[0]
+		a.liberarPagina(raiz)
+	}
+	return borrado
+}
+
+// borrarRec borra la clave del subárbol e indica si la página quedó vacía y fue liberada. La raíz nunca se
+// libera
+func (a *arbolBMas[K, V]) borrarRec(p *paginaBMas[K, V], clave K, esRaiz bool) (V, bool, bool) {
+	if p.tipo == paginaHoja {
+		var borrado V
+		pos := a.posicionHoja(p, clave)
+		if pos == len(p.Claves) || a.cmp(p.Claves[pos], clave) != 0 {
+			return borrado, false, false
+		}
+		borrado = p.Datos[pos]
+		p.Claves = quitarDe(p.Claves, pos)
+		p.Datos = quitarDe(p.Datos, pos)
+		p.modificar()
+		if len(p.Claves) > 0 || esRaiz {
+			return borrado, true, false
+		}
+		a.desenlazarHoja(p)
+		a.liberarPagina(p)
+		return borrado, true, true
+	}
+
+	pos := a.posicionHijo(p, clave)
+	borrado, ok, vacio := a.borrarRec(a.pagina(p.Hijos[pos]), clave, false)
+	if !vacio {
+		return borrado, ok, false
+	}
+	p.Hijos = quitarDe(p.Hijos, pos)
+	if pos > 0 {
+		p.Claves = quitarDe(p.Claves, pos-1)
+	} else if len(p.Claves) > 0 {
+		p.Claves = quitarDe(p.Claves, 0)
+	}
+	p.modificar()
+	if len(p.Hijos) > 0 || esRaiz {
+		return borrado, ok, false
+	}
+	a.liberarPagina(p)
+	return borrado, ok, true
+}
+
+func (a *arbolBMas[K, V]) desenlazarHoja(p *paginaBMas[K, V]) {
+	if p.Anterior != 0 {
+		anterior := a.pagina(p.Anterior)
+		anterior.Siguiente = p.Siguiente
+		anterior.modificar()
+	}
+	if p.Siguiente != 0 {
+		siguiente := a.pagina(p.Siguiente)
+		siguiente.Anterior = p.Anterior
+		siguiente.modificar()
+	}
+}
+
+func (a *arbolBMas[K, V]) Cantidad() int {
+	return a.cantidad
+}
+
+func (a *arbolBMas[K, V]) Iterar(visitar func(K, V) bool) {
+	a.IterarRango(nil, nil, visitar)
+}
+
+func (a *arbolBMas[K, V]) IterarRango(desde *K, hasta *K, visitar func(K, V) bool) {
+	iter := a.IteradorRango(desde, hasta)
+	for iter.HaySiguiente() {
+		clave, dato := iter.VerActual()
+		if !visitar(clave, dato) {
+			return
+		}
+		iter.Siguiente()
+	}
+}
+
+// iteradorBMas recorre las hojas enlazadas. Guarda el id de la hoja y no la página, ya que ésta puede ser
+// desalojada del cache entre llamadas
+type iteradorBMas[K comparable, V any] struct {
+	arbol *arbolBMas[K, V]
+	hoja  uint64
+	pos   int
+	hasta *K
+}
+
+func (a *arbolBMas[K, V]) Iterador() IterDiccionario[K, V] {
+	return a.IteradorRango(nil, nil)
+}
+
+func (a *arbolBMas[K, V]) IteradorRango(desde *K, hasta *K) IterDiccionario[K, V] {
+	defer a.ajustarCache()
+	iter := &iteradorBMas[K, V]{arbol: a, hasta: hasta}
+	var hoja *paginaBMas[K, V]
+	if desde == nil {
+		hoja = a.primeraHoja()
+	} else {
+		hoja = a.buscarHoja(*desde)
+		iter.pos = a.posicionHoja(hoja, *desde)
+	}
+	iter.hoja = hoja.id
+	iter.avanzarHoja(hoja)
+	return iter
+}
+
+// avanzarHoja pasa a la hoja siguiente si la posición actual quedó al final de la hoja
+func (iter *iteradorBMas[K, V]) avanzarHoja(hoja *paginaBMas[K, V]) {
+	for iter.hoja != 0 && iter.pos >= len(hoja.Claves) {
+		iter.hoja = hoja.Siguiente
+		iter.pos = 0
+		if iter.hoja != 0 {
+			hoja = iter.arbol.pagina(iter.hoja)
+		}
+	}
+}
+
+func (iter *iteradorBMas[K, V]) HaySiguiente() bool {
+	if iter.hoja == 0 {
+		return false
+	}
+	if iter.hasta == nil {
+		return true
+	}
+	defer iter.arbol.ajustarCache()
+	hoja := iter.arbol.pagina(iter.hoja)
+	return iter.arbol.cmp(hoja.Claves[iter.pos], *iter.hasta) <= 0
+}
+
+func (iter *iteradorBMas[K, V]) VerActual() (K, V) {
+	if !iter.HaySiguiente() {
+		panic("El iterador termino de iterar")
+	}
+	defer iter.arbol.ajustarCache()
+	hoja := iter.arbol.pagina(iter.hoja)
+	return hoja.Claves[iter.pos], hoja.Datos[iter.pos]
+}
+
+func (iter *iteradorBMas[K, V]) Siguiente() {
+	if !iter.HaySiguiente() {
+		panic("El iterador termino de iterar")
+	}
+	defer iter.arbol.ajustarCache()
+	iter.pos++
+	iter.avanzarHoja(iter.arbol.pagina(iter.hoja))
+}
+
+func (a *arbolBMas[K, V]) Sincronizar() error {
+	for elem := a.orden.Front(); elem != nil; elem = elem.Next() {
+		p := elem.Value.(*paginaBMas[K, V])
+		if p.sucia {
+			if err := a.escribirPagina(p); err != nil {
+				return err
+			}
+		}
+	}
+	if a.cabeceraSucia {
+		if err := a.escribirCabecera(); err != nil {
+			return err
+		}
+	}
+	return a.archivo.Sync()
+}
+
+func (a *arbolBMas[K, V]) Cerrar() error {
+	errSync := a.Sincronizar()
+	errCierre := a.archivo.Close()
+	if errSync != nil {
+		return errSync
+	}
+	return errCierre
+}
@@ -0,0 +1,195 @@
+package diccionario_test
+
+import (
+	"cmp"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	TDADiccionario "tdas/diccionario"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func crearArbolBMas(t *testing.T, ruta string) TDADiccionario.DiccionarioEnDisco[int, string] {
+	dic, err := TDADiccionario.CrearArbolBMas[int, string](ruta, cmp.Compare,
+		TDADiccionario.OpcionesArbolBMas{TamanioPagina: 512, PaginasEnCache: 4})
+	require.NoError(t, err)
+	return dic
+}
+
+func TestArbolBMasVacio(t *testing.T) {
+	t.Log("Comprueba que un árbol B+ recién creado no tiene claves")
+	dic := crearArbolBMas(t, filepath.Join(t.TempDir(), "arbol.db"))
+	defer dic.Cerrar()
+	require.EqualValues(t, 0, dic.Cantidad())
+	require.False(t, dic.Pertenece(0))
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Obtener(0) })
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar(0) })
+	require.False(t, dic.Iterador().HaySiguiente())
+}
+
+func TestArbolBMasVolumen(t *testing.T) {
+	t.Log("Guarda y borra muchas claves en orden aleatorio con un cache chico, forzando divisiones y desalojos")
+	dic := crearArbolBMas(t, filepath.Join(t.TempDir(), "arbol.db"))
+	defer dic.Cerrar()
+	claves := rand.New(rand.NewSource(1)).Perm(3000)
+	for _, clave := range claves {
+		dic.Guardar(clave, fmt.Sprint(clave))
+	}
+	require.EqualValues(t, len(claves), dic.Cantidad())
+	for _, clave := range claves {
+		require.EqualValues(t, fmt.Sprint(clave), dic.Obtener(clave))
+	}
+
+	for _, clave := range claves[:2000] {
+		require.EqualValues(t, fmt.Sprint(clave), dic.Borrar(clave))
+	}
+	require.EqualValues(t, 1000, dic.Cantidad())
+	for _, clave := range claves[:2000] {
+		require.False(t, dic.Pertenece(clave))
+	}
+
+	anterior := -1
+	cantidad := 0
+	dic.Iterar(func(clave int, dato string) bool {
+		require.Greater(t, clave, anterior)
+		require.EqualValues(t, fmt.Sprint(clave), dato)
+		anterior = clave
+		cantidad++
+		return true
+	})
+	require.EqualValues(t, 1000, cantidad)
+}
+
+func TestArbolBMasReabrir(t *testing.T) {
+	t.Log("El contenido se conserva al cerrar y volver a abrir el archivo")
+	ruta := filepath.Join(t.TempDir(), "arbol.db")
+	dic := crearArbolBMas(t, ruta)
+	for i := 0; i < 500; i++ {
+		dic.Guardar(i, strings.Repeat("x", i%20))
+	}
+	for i := 0; i < 500; i += 3 {
+		dic.Borrar(i)
+	}
+	require.NoError(t, dic.Cerrar())
+
+	dic = crearArbolBMas(t, ruta)
+	defer dic.Cerrar()
+	require.EqualValues(t, 333, dic.Cantidad())
+	for i := 0; i < 500; i++ {
+		if i%3 == 0 {
+			require.False(t, dic.Pertenece(i))
+		} else {
+			require.EqualValues(t, strings.Repeat("x", i%20), dic.Obtener(i))
+		}
+	}
+}
+
+func TestArbolBMasIteradorRango(t *testing.T) {
+	t.Log("El iterador de rango recorre las hojas enlazadas respetando ambos límites")
+	dic := crearArbolBMas(t, filepath.Join(t.TempDir(), "arbol.db"))
+	defer dic.Cerrar()
+	for i := 0; i < 1000; i += 2 {
+		dic.Guardar(i, fmt.Sprint(i))
+	}
+	desde, hasta := 101, 301
+	iter := dic.IteradorRango(&desde, &hasta)
+	claves := []int{}
+	for iter.HaySiguiente() {
+		clave, _ := iter.VerActual()
+		claves = append(claves, clave)
+		iter.Siguiente()
+	}
+	require.Len(t, claves, 100)
+	require.EqualValues(t, 102, claves[0])
+	require.EqualValues(t, 300, claves[len(claves)-1])
+	require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.Siguiente() })
+
+	desde = 2000
+	require.False(t, dic.IteradorRango(&desde, nil).HaySiguiente())
+
+	cantidad := 0
+	dic.IterarRango(nil, &hasta, func(clave int, _ string) bool {
+		cantidad++
+		return clave < 50
+	})
+	require.EqualValues(t, 26, cantidad)
+}
+
+func TestArbolBMasEntradaDemasiadoGrande(t *testing.T) {
+	t.Log("Un dato que no entra en una página produce un pánico sin modificar el árbol")
+	dic := crearArbolBMas(t, filepath.Join(t.TempDir(), "arbol.db"))
+	defer dic.Cerrar()
+	dic.Guardar(1, "a")
+	require.PanicsWithValue(t, TDADiccionario.ErrEntradaDemasiadoGrande, func() { dic.Guardar(2, strings.Repeat("x", 1000)) })
+	require.EqualValues(t, 1, dic.Cantidad())
+	require.False(t, dic.Pertenece(2))
+}
+
+func TestArbolBMasCabeceraConTamanioInvalido(t *testing.T) {
+	t.Log("Abrir un archivo cuya cabecera tiene un tamaño de página fuera de rango devuelve un error")
+	for _, tamanio := range []uint32{0, 17, math.MaxUint32} {
+		ruta := filepath.Join(t.TempDir(), "arbol.db")
+		dic := crearArbolBMas(t, ruta)
+		dic.Guardar(1, "a")
+		require.NoError(t, dic.Cerrar())
+
+		archivo, err := os.OpenFile(ruta, os.O_RDWR, 0)
+		require.NoError(t, err)
+		var campo [4]byte
+		binary.LittleEndian.PutUint32(campo[:], tamanio)
+		// El tamaño de página está justo después de la firma de 8 bytes
+		_, err = archivo.WriteAt(campo[:], 8)
+		require.NoError(t, err)
+		require.NoError(t, archivo.Close())
+
+		_, err = TDADiccionario.CrearArbolBMas[int, string](ruta, cmp.Compare, TDADiccionario.OpcionesArbolBMas{})
+		require.Error(t, err)
+	}
+}
+
+func TestArbolBMasPaginasLlenas(t *testing.T) {
+	t.Log("Páginas llenas hasta el límite, con claves y datos en cero, se dividen a tiempo y se recuperan al reabrir")
+	ruta := filepath.Join(t.TempDir(), "arbol.db")
+	dic := crearArbolBMas(t, ruta)
+	orden := rand.New(rand.NewSource(3)).Perm(3000)
+	for _, clave := range orden {
+		dic.Guardar(clave, strings.Repeat("x", clave%150))
+	}
+	for _, clave := range orden[:1000] {
+		dic.Guardar(clave, "")
+	}
+	require.NoError(t, dic.Cerrar())
+
+	dic = crearArbolBMas(t, ruta)
+	defer dic.Cerrar()
+	require.EqualValues(t, 3000, dic.Cantidad())
+	for i, clave := range orden {
+		esperado := strings.Repeat("x", clave%150)
+		if i < 1000 {
+			esperado = ""
+		}
+		require.EqualValues(t, esperado, dic.Obtener(clave))
+	}
+}
+
+func TestArbolBMasBorrarTodo(t *testing.T) {
+	t.Log("Al borrar todas las claves las páginas liberadas se reutilizan en inserciones posteriores")
+	dic := crearArbolBMas(t, filepath.Join(t.TempDir(), "arbol.db"))
+	defer dic.Cerrar()
+	for ronda := 0; ronda < 3; ronda++ {
+		for i := 0; i < 800; i++ {
+			dic.Guardar(i, fmt.Sprint(i))
+		}
+		for i := 799; i >= 0; i-- {
+			dic.Borrar(i)
+		}
+		require.EqualValues(t, 0, dic.Cantidad())
+		require.False(t, dic.Iterador().HaySiguiente())
+	}
+}
@@ -0,0 +1,213 @@
+package diccionario
+
+import TDAPila "tdas/pila"
+
+// Intervalo es un intervalo cerrado [Inicio, Fin]
+type Intervalo[T any] struct {
+	Inicio T
+	Fin    T
+}
+
+// ArbolIntervalos es un diccionario cuyas claves son intervalos, ordenados por inicio y luego por fin, que
+// permite encontrar eficientemente los intervalos que se solapan con un punto o con otro intervalo
+type ArbolIntervalos[T comparable, V any] interface {
+	Visualizable[Intervalo[T], V]
+
+	// Guardar guarda el par intervalo-dato. Si el intervalo ya se encontraba, se actualiza el dato. Si el inicio
+	// es mayor al fin, entra en pánico con un mensaje 'El intervalo es invalido'
+	Guardar(intervalo Intervalo[T], dato V)
+
+	// Pertenece determina si el intervalo se encuentra guardado
+	Pertenece(intervalo Intervalo[T]) bool
+
+	// Obtener devuelve el dato del intervalo. Si no se encuentra, entra en pánico con un mensaje
+	// 'La clave no pertenece al diccionario'
+	Obtener(intervalo Intervalo[T]) V
+
+	// Borrar borra el intervalo y devuelve su dato. Si no se encuentra, entra en pánico con un mensaje
+	// 'La clave no pertenece al diccionario'
+	Borrar(intervalo Intervalo[T]) V
+
+	// Cantidad devuelve la cantidad de intervalos guardados
+	Cantidad() int
+
+	// Iterar visita todos los intervalos en orden
+	Iterar(visitar func(intervalo Intervalo[T], dato V) bool)
+
+	// Iterador devuelve un IterDiccionario sobre todos los intervalos en orden
+	Iterador() IterDiccionario[Intervalo[T], V]
+
+	// IterarContienen visita en orden los intervalos que contienen al punto
+	IterarContienen(punto T, visitar func(intervalo Intervalo[T], dato V) bool)
+
+	// IteradorContienen devuelve un IterDiccionario sobre los intervalos que contienen al punto
+	IteradorContienen(punto T) IterDiccionario[Intervalo[T], V]
+
+	// IterarSolapan visita en orden los intervalos que tienen al menos un punto en común con el indicado
+	IterarSolapan(intervalo Intervalo[T], visitar func(intervalo Intervalo[T], dato V) bool)
+
+	// IteradorSolapan devuelve un IterDiccionario sobre los intervalos que se solapan con el indicado
+	IteradorSolapan(intervalo Intervalo[T]) IterDiccionario[Intervalo[T], V]
+}
+
+// arbolIntervalos es un AVL aumentado con el mayor fin de cada subárbol. Si ese máximo es menor al inicio del
+// intervalo buscado, ningún intervalo del subárbol puede solaparse con él
+type arbolIntervalos[T comparable, V any] struct {
+	arbol *avl[Intervalo[T], V, T]
+	cmp   func(T, T) int
+}
+
+// CrearArbolIntervalos crea un árbol de intervalos vacío. Las operaciones de guardado y borrado cuestan
+// O(log n), y las consultas de solapamiento O(min(n, k log n)) siendo k la cantidad de intervalos encontrados
+func CrearArbolIntervalos[T comparable, V any](cmp func(T, T) int) ArbolIntervalos[T, V] {
+	compararIntervalos := func(a, b Intervalo[T]) int {
+		if res := cmp(a.Inicio, b.Inicio); res != 0 {
+			return res
+		}
+		return cmp(a.Fin, b.Fin)
+	}
+	maximoFin := func(n *nodoAVL[Intervalo[T], V, T]) T {
+		maximo := n.clave.Fin
+		if n.izq != nil && cmp(n.izq.aumento, maximo) > 0 {
+			maximo = n.izq.aumento
+		}
+		if n.der != nil && cmp(n.der.aumento, maximo) > 0 {
+			maximo = n.der.aumento
+		}
+		return maximo
+	}
+	return &arbolIntervalos[T, V]{
+		arbol: &avl[Intervalo[T], V, T]{cmp: compararIntervalos, aumentar: maximoFin},
+		cmp:   cmp,
+	}
+}
+
+func (a *arbolIntervalos[T, V]) Guardar(intervalo Intervalo[T], dato V) {
+	if a.cmp(intervalo.Inicio, intervalo.Fin) > 0 {
+		panic("El intervalo es invalido")
+	}
+	a.arbol.Guardar(intervalo, dato)
+}
+
+func (a *arbolIntervalos[T, V]) Pertenece(intervalo Intervalo[T]) bool {
+	return a.arbol.Pertenece(intervalo)
+}
+
+func (a *arbolIntervalos[T, V]) Obtener(intervalo Intervalo[T]) V {
+	return a.arbol.Obtener(intervalo)
+}
+
+func (a *arbolIntervalos[T, V]) Borrar(intervalo Intervalo[T]) V {
+	return a.arbol.Borrar(intervalo)
+}
+
+func (a *arbolIntervalos[T, V]) Cantidad() int {
+	return a.arbol.Cantidad()
+}
+
+func (a *arbolIntervalos[T, V]) Iterar(visitar func(Intervalo[T], V) bool) {
+	a.arbol.Iterar(visitar)
+}
+
+func (a *arbolIntervalos[T, V]) Iterador() IterDiccionario[Intervalo[T], V] {
+	return a.arbol.Iterador()
+}
+
+func (a *arbolIntervalos[T, V]) IterarContienen(punto T, visitar func(Intervalo[T], V) bool) {
+	a.IterarSolapan(Intervalo[T]{Inicio: punto, Fin: punto}, visitar)
+}
+
+func (a *arbolIntervalos[T, V]) IteradorContienen(punto T) IterDiccionario[Intervalo[T], V] {
+	return a.IteradorSolapan(Intervalo[T]{Inicio: punto, Fin: punto})
+}
+
+func (a *arbolIntervalos[T, V]) IterarSolapan(intervalo Intervalo[T], visitar func(Intervalo[T], V) bool) {
+	a.iterarSolapan(a.arbol.raiz, intervalo, visitar)
+}
+
+func (a *arbolIntervalos[T, V]) solapan(x, y Intervalo[T]) bool {
+	return a.cmp(x.Inicio, y.Fin) <= 0 && a.cmp(y.Inicio, x.Fin) <= 0
+}
+
+func (a *arbolIntervalos[T, V]) iterarSolapan(n *nodoAVL[Intervalo[T], V, T], intervalo Intervalo[T], visitar func(Intervalo[T], V) bool) bool {
+	if n == nil || a.cmp(n.aumento, intervalo.Inicio) < 0 {
+		return true
+	}
+	if !a.iterarSolapan(n.izq, intervalo, visitar) {
+		return false
+	}
+	if a.cmp(n.clave.Inicio, intervalo.Fin) > 0 {
+		// Este nodo y todo su subárbol derecho empiezan después del intervalo buscado
+		return true
+	}
+	if a.solapan(n.clave, intervalo) && !visitar(n.clave, n.dato) {
+		return false
+	}
+	return a.iterarSolapan(n.der, intervalo, visitar)
+}
+
+// iteradorSolapan es un iterador in-order que no desciende a los subárboles cuyo mayor fin es menor al inicio
+// buscado, y termina al llegar a un intervalo que empieza después del fin buscado
+type iteradorSolapan[T comparable, V any] struct {
+	arbol     *arbolIntervalos[T, V]
+	pila      TDAPila.Pila[*nodoAVL[Intervalo[T], V, T]]
+	intervalo Intervalo[T]
+}
+
+func (a *arbolIntervalos[T, V]) IteradorSolapan(intervalo Intervalo[T]) IterDiccionario[Intervalo[T], V] {
+	iter := &iteradorSolapan[T, V]{
+		arbol:     a,
+		pila:      TDAPila.CrearPilaDinamica[*nodoAVL[Intervalo[T], V, T]](),
+		intervalo: intervalo,
+	}
+	iter.apilarIzquierdos(a.arbol.raiz)
+	iter.avanzarHastaSolapado()
+	return iter
+}
+
+func (iter *iteradorSolapan[T, V]) apilarIzquierdos(n *nodoAVL[Intervalo[T], V, T]) {
+	for n != nil && iter.arbol.cmp(n.aumento, iter.intervalo.Inicio) >= 0 {
+		iter.pila.Apilar(n)
+		n = n.izq
+	}
+}
+
+// avanzarHastaSolapado deja en el tope de la pila al próximo intervalo que se solapa, o vacía la pila si no
+// quedan
+func (iter *iteradorSolapan[T, V]) avanzarHastaSolapado() {
+	for !iter.pila.EstaVacia() {
+		n := iter.pila.VerTope()
+		if iter.arbol.cmp(n.clave.Inicio, iter.intervalo.Fin) > 0 {
+			for !iter.pila.EstaVacia() {
+				iter.pila.Desapilar()
+			}
+			return
+		}
+		if iter.arbol.solapan(n.clave, iter.intervalo) {
+			return
+		}
+		iter.pila.Desapilar()
+		iter.apilarIzquierdos(n.der)
+	}
+}
+
+func (iter *iteradorSolapan[T, V]) HaySiguiente() bool {
+	return !iter.pila.EstaVacia()
+}
+
+func (iter *iteradorSolapan[T, V]) VerActual() (Intervalo[T], V) {
+	if !iter.HaySiguiente() {
+		panic("El iterador termino de iterar")
+	}
+	n := iter.pila.VerTope()
+	return n.clave, n.dato
+}
+
+func (iter *iteradorSolapan[T, V]) Siguiente() {
+	if !iter.HaySiguiente() {
+		panic("El iterador termino de iterar")
+	}
+	n := iter.pila.Desapilar()
+	iter.apilarIzquierdos(n.der)
+	iter.avanzarHastaSolapado()
+}
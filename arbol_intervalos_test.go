@@ -0,0 +1,112 @@
+package diccionario_test
+
+import (
+	"cmp"
+	"math/rand"
+	TDADiccionario "tdas/diccionario"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type intervaloInt = TDADiccionario.Intervalo[int]
+
+func intervalosSolapados(arbol TDADiccionario.ArbolIntervalos[int, string], buscado intervaloInt) ([]intervaloInt, []intervaloInt) {
+	internos := []intervaloInt{}
+	arbol.IterarSolapan(buscado, func(intervalo intervaloInt, _ string) bool {
+		internos = append(internos, intervalo)
+		return true
+	})
+	externos := []intervaloInt{}
+	for iter := arbol.IteradorSolapan(buscado); iter.HaySiguiente(); iter.Siguiente() {
+		intervalo, _ := iter.VerActual()
+		externos = append(externos, intervalo)
+	}
+	return internos, externos
+}
+
+func TestArbolIntervalosVacio(t *testing.T) {
+	t.Log("Comprueba que un árbol de intervalos vacío no tiene intervalos ni solapamientos")
+	arbol := TDADiccionario.CrearArbolIntervalos[int, string](cmp.Compare)
+	require.EqualValues(t, 0, arbol.Cantidad())
+	require.False(t, arbol.Pertenece(intervaloInt{1, 2}))
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { arbol.Borrar(intervaloInt{1, 2}) })
+	require.False(t, arbol.IteradorContienen(0).HaySiguiente())
+	require.PanicsWithValue(t, "El intervalo es invalido", func() { arbol.Guardar(intervaloInt{3, 2}, "") })
+}
+
+func TestArbolIntervalosReservas(t *testing.T) {
+	t.Log("Encuentra las reservas que contienen un punto y las que se solapan con otra reserva")
+	arbol := TDADiccionario.CrearArbolIntervalos[int, string](cmp.Compare)
+	arbol.Guardar(intervaloInt{15, 20}, "a")
+	arbol.Guardar(intervaloInt{10, 30}, "b")
+	arbol.Guardar(intervaloInt{17, 19}, "c")
+	arbol.Guardar(intervaloInt{5, 20}, "d")
+	arbol.Guardar(intervaloInt{12, 15}, "e")
+	arbol.Guardar(intervaloInt{30, 40}, "f")
+	require.EqualValues(t, 6, arbol.Cantidad())
+
+	contienen := []string{}
+	arbol.IterarContienen(16, func(_ intervaloInt, dato string) bool {
+		contienen = append(contienen, dato)
+		return true
+	})
+	require.EqualValues(t, []string{"d", "b", "a"}, contienen)
+
+	iter := arbol.IteradorContienen(30)
+	intervalo, dato := iter.VerActual()
+	require.EqualValues(t, intervaloInt{10, 30}, intervalo)
+	require.EqualValues(t, "b", dato)
+	iter.Siguiente()
+	intervalo, _ = iter.VerActual()
+	require.EqualValues(t, intervaloInt{30, 40}, intervalo)
+	iter.Siguiente()
+	require.False(t, iter.HaySiguiente())
+	require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.VerActual() })
+
+	internos, externos := intervalosSolapados(arbol, intervaloInt{0, 11})
+	require.EqualValues(t, []intervaloInt{{5, 20}, {10, 30}}, internos)
+	require.EqualValues(t, internos, externos)
+
+	require.EqualValues(t, "b", arbol.Borrar(intervaloInt{10, 30}))
+	internos, externos = intervalosSolapados(arbol, intervaloInt{21, 35})
+	require.EqualValues(t, []intervaloInt{{30, 40}}, internos)
+	require.EqualValues(t, internos, externos)
+}
+
+func TestArbolIntervalosVolumen(t *testing.T) {
+	t.Log("Las consultas coinciden con una búsqueda lineal luego de muchas inserciones y borrados")
+	arbol := TDADiccionario.CrearArbolIntervalos[int, string](cmp.Compare)
+	aleatorio := rand.New(rand.NewSource(5))
+	guardados := map[intervaloInt]bool{}
+	for i := 0; i < 2000; i++ {
+		inicio := aleatorio.Intn(1000)
+		intervalo := intervaloInt{inicio, inicio + aleatorio.Intn(50)}
+		arbol.Guardar(intervalo, "")
+		guardados[intervalo] = true
+	}
+	for intervalo := range guardados {
+		if aleatorio.Intn(2) == 0 {
+			arbol.Borrar(intervalo)
+			delete(guardados, intervalo)
+		}
+	}
+	require.EqualValues(t, len(guardados), arbol.Cantidad())
+
+	for i := 0; i < 100; i++ {
+		inicio := aleatorio.Intn(1100) - 50
+		buscado := intervaloInt{inicio, inicio + aleatorio.Intn(30)}
+		esperados := 0
+		for intervalo := range guardados {
+			if intervalo.Inicio <= buscado.Fin && buscado.Inicio <= intervalo.Fin {
+				esperados++
+			}
+		}
+		internos, externos := intervalosSolapados(arbol, buscado)
+		require.Len(t, internos, esperados)
+		require.EqualValues(t, internos, externos)
+		for _, intervalo := range internos {
+			require.True(t, guardados[intervalo])
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package diccionario_test
+
+import (
+	"math/rand"
+	"sort"
+	TDADiccionario "tdas/diccionario"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// verificarContratoOrdenado aplica una secuencia aleatoria de operaciones sobre el diccionario vacío y sobre un
+// map, y comprueba que ambos coincidan, incluyendo el orden y los límites de las iteraciones por rango
+func verificarContratoOrdenado(t *testing.T, dic TDADiccionario.DiccionarioOrdenado[int, int], semilla int64) {
+	aleatorio := rand.New(rand.NewSource(semilla))
+	esperado := map[int]int{}
+	for i := 0; i < 3000; i++ {
+		clave := aleatorio.Intn(500)
+		if aleatorio.Intn(3) == 0 {
+			if _, ok := esperado[clave]; ok {
+				require.EqualValues(t, esperado[clave], dic.Borrar(clave))
+				delete(esperado, clave)
+			} else {
+				require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar(clave) })
+			}
+		} else {
+			esperado[clave] = i
+			dic.Guardar(clave, i)
+		}
+		require.EqualValues(t, len(esperado), dic.Cantidad())
+	}
+
+	claves := make([]int, 0, len(esperado))
+	for clave, dato := range esperado {
+		require.True(t, dic.Pertenece(clave))
+		require.EqualValues(t, dato, dic.Obtener(clave))
+		claves = append(claves, clave)
+	}
+	sort.Ints(claves)
+
+	for i := 0; i < 50; i++ {
+		desde, hasta := aleatorio.Intn(520)-10, aleatorio.Intn(520)-10
+		var limiteDesde, limiteHasta *int
+		if i%5 != 0 {
+			limiteDesde = &desde
+		}
+		if i%7 != 0 {
+			limiteHasta = &hasta
+		}
+		enRango := []int{}
+		for _, clave := range claves {
+			if (limiteDesde == nil || clave >= desde) && (limiteHasta == nil || clave <= hasta) {
+				enRango = append(enRango, clave)
+			}
+		}
+
+		internas := []int{}
+		dic.IterarRango(limiteDesde, limiteHasta, func(clave int, dato int) bool {
+			require.EqualValues(t, esperado[clave], dato)
+			internas = append(internas, clave)
+			return true
+		})
+		require.EqualValues(t, enRango, internas)
+
+		externas := []int{}
+		for iter := dic.IteradorRango(limiteDesde, limiteHasta); iter.HaySiguiente(); iter.Siguiente() {
+			clave, dato := iter.VerActual()
+			require.EqualValues(t, esperado[clave], dato)
+			externas = append(externas, clave)
+		}
+		require.EqualValues(t, enRango, externas)
+	}
+
+	todas := []int{}
+	for iter := dic.Iterador(); iter.HaySiguiente(); iter.Siguiente() {
+		clave, _ := iter.VerActual()
+		todas = append(todas, clave)
+	}
+	require.EqualValues(t, claves, todas)
+}
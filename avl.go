@@ -0,0 +1,294 @@
+package diccionario
+
+import TDAPila "tdas/pila"
+
+// nodoAVL es un nodo de un AVL aumentado: además de su altura, guarda un valor de tipo A calculado a partir del
+// propio nodo y de los aumentos de sus hijos (por ejemplo, el máximo de un campo en todo el subárbol)
+type nodoAVL[K comparable, V any, A any] struct {
+	clave   K
+	dato    V
+	izq     *nodoAVL[K, V, A]
+	der     *nodoAVL[K, V, A]
+	altura  int
+	aumento A
+}
+
+// avl es un ABB balanceado por alturas que mantiene actualizado el aumento de cada nodo ante inserciones,
+// borrados y rotaciones. Es la base de los árboles aumentados del paquete; no se exporta directamente
+type avl[K comparable, V any, A any] struct {
+	raiz     *nodoAVL[K, V, A]
+	cantidad int
+	cmp      func(K, K) int
+
+	// aumentar calcula el aumento de un nodo cuyos hijos ya están actualizados. Puede ser nil
+	aumentar func(n *nodoAVL[K, V, A]) A
+}
+
+func alturaAVL[K comparable, V any, A any](n *nodoAVL[K, V, A]) int {
+	if n == nil {
+		return 0
+	}
+	return n.altura
+}
+
+// actualizar recalcula la altura y el aumento del nodo a partir de sus hijos
+func (a *avl[K, V, A]) actualizar(n *nodoAVL[K, V, A]) {
+	n.altura = 1 + max(alturaAVL(n.izq), alturaAVL(n.der))
+	if a.aumentar != nil {
+		n.aumento = a.aumentar(n)
+	}
+}
+
+func (a *avl[K, V, A]) rotarDerecha(n *nodoAVL[K, V, A]) *nodoAVL[K, V, A] {
+	hijo := n.izq
+	n.izq = hijo.der
+	hijo.der = n
+	a.actualizar(n)
+	a.actualizar(hijo)
+	return hijo
+}
+
+func (a *avl[K, V, A]) rotarIzquierda(n *nodoAVL[K, V, A]) *nodoAVL[K, V, A] {
+	hijo := n.der
+	n.der = hijo.izq
+	hijo.izq = n
+	a.actualizar(n)
+	a.actualizar(hijo)
+	return hijo
+}
+
+// balancear actualiza el nodo y, si la diferencia de alturas entre sus hijos es mayor a uno, lo rota
+func (a *avl[K, V, A]) balancear(n *nodoAVL[K, V, A]) *nodoAVL[K, V, A] {
+	a.actualizar(n)
+	factor := alturaAVL(n.izq) - alturaAVL(n.der)
+	if factor > 1 {
+		if alturaAVL(n.izq.izq) < alturaAVL(n.izq.der) {
+			n.izq = a.rotarIzquierda(n.izq)
+		}
+		return a.rotarDerecha(n)
+	}
+	if factor < -1 {
+		if alturaAVL(n.der.der) < alturaAVL(n.der.izq) {
+			n.der = a.rotarDerecha(n.der)
+		}
+		return a.rotarIzquierda(n)
+	}
+	return n
+}
+
+func (a *avl[K, V, A]) Guardar(clave K, dato V) {
+	a.raiz = a.guardarRec(a.raiz, clave, dato)
+}
+
+func (a *avl[K, V, A]) guardarRec(n *nodoAVL[K, V, A], clave K, dato V) *nodoAVL[K, V, A] {
+	if n == nil {
+		a.cantidad++
+		nuevo := &nodoAVL[K, V, A]{clave: clave, dato: dato}
+		a.actualizar(nuevo)
+		return nuevo
+	}
+	cmp := a.cmp(clave, n.clave)
+	if cmp < 0 {
+		n.izq = a.guardarRec(n.izq, clave, dato)
+	} else if cmp > 0 {
+		n.der = a.guardarRec(n.der, clave, dato)
+	} else {
+		n.dato = dato
+	}
+	return a.balancear(n)
+}
+
+func (a *avl[K, V, A]) buscarNodo(clave K) *nodoAVL[K, V, A] {
+	n := a.raiz
+	for n != nil {
+		cmp := a.cmp(clave, n.clave)
+		if cmp < 0 {
+			n = n.izq
+		} else if cmp > 0 {
+			n = n.der
+		} else {
+			return n
+		}
+	}
+	return nil
+}
+
+// minimo devuelve el nodo con la menor clave, o nil si el árbol está vacío
+func (a *avl[K, V, A]) minimo() *nodoAVL[K, V, A] {
+	n := a.raiz
+	for n != nil && n.izq != nil {
+		n = n.izq
+	}
+	return n
+}
+
+// buscarTecho devuelve el nodo con la menor clave mayor o igual a la indicada, o nil si no hay
+func (a *avl[K, V, A]) buscarTecho(clave K) *nodoAVL[K, V, A] {
+	var techo *nodoAVL[K, V, A]
+	for n := a.raiz; n != nil; {
+		cmp := a.cmp(clave, n.clave)
+		if cmp == 0 {
+			return n
+		}
+		if cmp < 0 {
+			techo = n
+			n = n.izq
+		} else {
+			n = n.der
+		}
+	}
+	return techo
+}
+
+// buscarSucesor devuelve el nodo con la menor clave estrictamente mayor a la indicada, o nil si no hay
+func (a *avl[K, V, A]) buscarSucesor(clave K) *nodoAVL[K, V, A] {
+	var sucesor *nodoAVL[K, V, A]
+	for n := a.raiz; n != nil; {
+		if a.cmp(clave, n.clave) < 0 {
+			sucesor = n
+			n = n.izq
+		} else {
+			n = n.der
+		}
+	}
+	return sucesor
+}
+
+func (a *avl[K, V, A]) Pertenece(clave K) bool {
+	return a.buscarNodo(clave) != nil
+}
+
+func (a *avl[K, V, A]) Obtener(clave K) V {
+	n := a.buscarNodo(clave)
+	if n == nil {
+		panic("La clave no pertenece al diccionario")
+	}
+	return n.dato
+}
+
+func (a *avl[K, V, A]) Borrar(clave K) V {
+	n := a.buscarNodo(clave)
+	if n == nil {
+		panic("La clave no pertenece al diccionario")
+	}
+	borrado := n.dato
+	a.raiz = a.borrarRec(a.raiz, clave)
+	a.cantidad--
+	return borrado
+}
+
+func (a *avl[K, V, A]) borrarRec(n *nodoAVL[K, V, A], clave K) *nodoAVL[K, V, A] {
+	cmp := a.cmp(clave, n.clave)
+	if cmp < 0 {
+		n.izq = a.borrarRec(n.izq, clave)
+		return a.balancear(n)
+	}
+	if cmp > 0 {
+		n.der = a.borrarRec(n.der, clave)
+		return a.balancear(n)
+	}
+	if n.izq == nil {
+		return n.der
+	}
+	if n.der == nil {
+		return n.izq
+	}
+
+	// Caso con dos hijos: el sucesor in-order ocupa el lugar del nodo
+	var sucesor *nodoAVL[K, V, A]
+	n.der, sucesor = a.sacarMinimo(n.der)
+	sucesor.izq = n.izq
+	sucesor.der = n.der
+	return a.balancear(sucesor)
+}
+
+// sacarMinimo desengancha el mínimo del subárbol, rebalanceando el camino, y lo devuelve
+func (a *avl[K, V, A]) sacarMinimo(n *nodoAVL[K, V, A]) (*nodoAVL[K, V, A], *nodoAVL[K, V, A]) {
+	if n.izq == nil {
+		return n.der, n
+	}
+	var minimo *nodoAVL[K, V, A]
+	n.izq, minimo = a.sacarMinimo(n.izq)
+	return a.balancear(n), minimo
+}
+
+func (a *avl[K, V, A]) Cantidad() int {
+	return a.cantidad
+}
+
+func (a *avl[K, V, A]) Iterar(visitar func(K, V) bool) {
+	a.iterarRango(a.raiz, nil, nil, visitar)
+}
+
+func (a *avl[K, V, A]) IterarRango(desde *K, hasta *K, visitar func(K, V) bool) {
+	a.iterarRango(a.raiz, desde, hasta, visitar)
+}
+
+func (a *avl[K, V, A]) iterarRango(n *nodoAVL[K, V, A], desde *K, hasta *K, visitar func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	mayorADesde := desde == nil || a.cmp(n.clave, *desde) >= 0
+	menorAHasta := hasta == nil || a.cmp(n.clave, *hasta) <= 0
+	if mayorADesde && !a.iterarRango(n.izq, desde, hasta, visitar) {
+		return false
+	}
+	if mayorADesde && menorAHasta && !visitar(n.clave, n.dato) {
+		return false
+	}
+	if menorAHasta {
+		return a.iterarRango(n.der, desde, hasta, visitar)
+	}
+	return true
+}
+
+type iteradorAVL[K comparable, V any, A any] struct {
+	pila  TDAPila.Pila[*nodoAVL[K, V, A]]
+	cmp   func(K, K) int
+	desde *K
+	hasta *K
+}
+
+// apilarDesdeHasta apila el camino de hijos izquierdos del nodo, salteando los nodos fuera del rango
+func (iter *iteradorAVL[K, V, A]) apilarDesdeHasta(n *nodoAVL[K, V, A]) {
+	for n != nil {
+		if iter.desde != nil && iter.cmp(n.clave, *iter.desde) < 0 {
+			n = n.der
+		} else if iter.hasta != nil && iter.cmp(n.clave, *iter.hasta) > 0 {
+			n = n.izq
+		} else {
+			iter.pila.Apilar(n)
+			n = n.izq
+		}
+	}
+}
+
+func (a *avl[K, V, A]) Iterador() IterDiccionario[K, V] {
+	return a.IteradorRango(nil, nil)
+}
+
+func (a *avl[K, V, A]) IteradorRango(desde *K, hasta *K) IterDiccionario[K, V] {
+	iter := &iteradorAVL[K, V, A]{pila: TDAPila.CrearPilaDinamica[*nodoAVL[K, V, A]](), cmp: a.cmp, desde: desde, hasta: hasta}
+	iter.apilarDesdeHasta(a.raiz)
+	return iter
+}
+
+func (iter *iteradorAVL[K, V, A]) HaySiguiente() bool {
+	return !iter.pila.EstaVacia()
+}
+
+func (iter *iteradorAVL[K, V, A]) VerActual() (K, V) {
+	if !iter.HaySiguiente() {
+		panic("El iterador termino de iterar")
+	}
+	n := iter.pila.VerTope()
+	return n.clave, n.dato
+}
+
+func (iter *iteradorAVL[K, V, A]) Siguiente() {
+	if !iter.HaySiguiente() {
+		panic("El iterador termino de iterar")
+	}
+	n := iter.pila.Desapilar()
+	iter.apilarDesdeHasta(n.der)
+}
@@ -0,0 +1,285 @@
+// Package codificacion implementa codificaciones de claves a bytes que preservan el orden: si a < b según el
+// orden natural del tipo, bytes.Compare(codificar(a), codificar(b)) < 0. Sirven para guardar las claves de un
+// diccionario ordenado en almacenamientos que sólo ordenan por bytes.
+//
+// Todas las codificaciones se delimitan a sí mismas, por lo que pueden concatenarse para formar tuplas que se
+// ordenan lexicográficamente por componente.
+package codificacion
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Codificador convierte valores de tipo T a bytes preservando el orden, y viceversa
+type Codificador[T any] interface {
+	// Codificar agrega la codificación del valor al final de dst y devuelve el slice resultante
+	Codificar(dst []byte, valor T) ([]byte, error)
+
+	// Decodificar lee un valor del principio de src y devuelve el resto de los bytes
+	Decodificar(src []byte) (T, []byte, error)
+}
+
+var (
+	// ErrDatosInsuficientes indica que los bytes a decodificar terminan antes de completar el valor
+	ErrDatosInsuficientes = errors.New("datos insuficientes para decodificar")
+
+	// ErrDatosInvalidos indica que los bytes a decodificar no son una codificación válida
+	ErrDatosInvalidos = errors.New("datos invalidos para decodificar")
+
+	// ErrNaN indica que se intentó codificar un NaN con la política NaNInvalido
+	ErrNaN = errors.New("NaN no puede codificarse")
+)
+
+// Signado son los tipos enteros con signo
+type Signado interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+type entero[T Signado] struct{}
+
+// Entero codifica enteros con signo en 8 bytes big-endian con el bit de signo invertido, de forma que los
+// negativos queden antes que los positivos
+func Entero[T Signado]() Codificador[T] {
+	return entero[T]{}
+}
+
+func (entero[T]) Codificar(dst []byte, valor T) ([]byte, error) {
+	return binary.BigEndian.AppendUint64(dst, uint64(int64(valor))^(1<<63)), nil
+}
+
+func (entero[T]) Decodificar(src []byte) (T, []byte, error) {
+	if len(src) < 8 {
+		return 0, src, ErrDatosInsuficientes
+	}
+	return T(int64(binary.BigEndian.Uint64(src) ^ (1 << 63))), src[8:], nil
+}
+
+// PoliticaNaN indica cómo se ordenan los NaN respecto del resto de los flotantes
+type PoliticaNaN int
+
+const (
+	// NaNPrimero ubica a todos los NaN, como un único valor, antes que -Inf (igual que cmp.Compare)
+	NaNPrimero PoliticaNaN = iota
+
+	// NaNUltimo ubica a todos los NaN, como un único valor, después de +Inf
+	NaNUltimo
+
+	// NaNInvalido hace que codificar un NaN devuelva ErrNaN
+	NaNInvalido
+)
+
+// PoliticaCeroNegativo indica cómo se ordena -0 respecto de +0
+type PoliticaCeroNegativo int
+
+const (
+	// CeroNegativoIgual codifica -0 como +0, por lo que son la misma clave (igual que == y cmp.Compare). Al
+	// decodificar se obtiene +0
+	CeroNegativoIgual PoliticaCeroNegativo = iota
+
+	// CeroNegativoMenor conserva el signo del cero, ubicando a -0 inmediatamente antes que +0
+	CeroNegativoMenor
+)
+
+type flotante struct {
+	nan  PoliticaNaN
+	cero PoliticaCeroNegativo
+}
+
+// Flotante codifica float64 en 8 bytes: a los positivos se les invierte el bit de signo y a los negativos todos
+// los bits, de forma que su orden como enteros sin signo coincida con el orden de los flotantes
+func Flotante(nan PoliticaNaN, cero PoliticaCeroNegativo) Codificador[float64] {
+	return flotante{nan: nan, cero: cero}
+}
+
+func (f flotante) Codificar(dst []byte, valor float64) ([]byte, error) {
+	var bits uint64
+	switch {
+	case math.IsNaN(valor) && f.nan == NaNInvalido:
+		return dst, ErrNaN
+	case math.IsNaN(valor) && f.nan == NaNPrimero:
+		bits = 0
+	case math.IsNaN(valor):
+		bits = math.MaxUint64
+	default:
+		if valor == 0 && f.cero == CeroNegativoIgual {
+			valor = 0
+		}
+		bits = math.Float64bits(valor)
+		if bits&(1<<63) != 0 {
+			bits = ^bits
+		} else {
+			bits |= 1 << 63
+		}
+	}
+	return binary.BigEndian.AppendUint64(dst, bits), nil
+}
+
+func (f flotante) Decodificar(src []byte) (float64, []byte, error) {
+	if len(src) < 8 {
+		return 0, src, ErrDatosInsuficientes
+	}
+	bits := binary.BigEndian.Uint64(src)
+	if bits == 0 || bits == math.MaxUint64 {
+		return math.NaN(), src[8:], nil
+	}
+	if bits&(1<<63) != 0 {
+		bits &^= 1 << 63
+	} else {
+		bits = ^bits
+	}
+	return math.Float64frombits(bits), src[8:], nil
+}
+
+type cadena struct{}
+
+// Cadena codifica strings en orden de bytes (el de strings.Compare). Cada 0x00 se escapa como 0x00 0xFF y la
+// cadena termina con 0x00 0x01, que es menor que cualquier byte que pueda seguir en una cadena más larga y que
+// cualquier 0x00 escapado. Como el terminador ocupa dos bytes, no se confunde con el comienzo del componente
+// siguiente de una tupla, aunque éste empiece con 0xFF
+func Cadena() Codificador[string] {
+	return cadena{}
+}
+
+func (cadena) Codificar(dst []byte, valor string) ([]byte, error) {
+	for i := 0; i < len(valor); i++ {
+		dst = append(dst, valor[i])
+		if valor[i] == 0x00 {
+			dst = append(dst, 0xFF)
+		}
+	}
+	return append(dst, 0x00, 0x01), nil
+}
+
+func (cadena) Decodificar(src []byte) (string, []byte, error) {
+	var valor []byte
+	for i := 0; i < len(src); i++ {
+		if src[i] != 0x00 {
+			valor = append(valor, src[i])
+			continue
+		}
+		if i+1 == len(src) {
+			break
+		}
+		switch src[i+1] {
+		case 0xFF:
+			valor = append(valor, 0x00)
+			i++
+		case 0x01:
+			return string(valor), src[i+2:], nil
+		default:
+			return "", src, ErrDatosInvalidos
+		}
+	}
+	return "", src, ErrDatosInsuficientes
+}
+
+type tiempo struct{}
+
+// Tiempo codifica instantes como los segundos desde la época Unix (con Entero) seguidos de los nanosegundos en
+// 4 bytes. Sólo se conserva el instante: al decodificar se obtiene en UTC y sin lectura monotónica
+func Tiempo() Codificador[time.Time] {
+	return tiempo{}
+}
+
+func (tiempo) Codificar(dst []byte, valor time.Time) ([]byte, error) {
+	dst, _ = entero[int64]{}.Codificar(dst, valor.Unix())
+	return binary.BigEndian.AppendUint32(dst, uint32(valor.Nanosecond())), nil
+}
+
+func (tiempo) Decodificar(src []byte) (time.Time, []byte, error) {
+	segundos, resto, err := entero[int64]{}.Decodificar(src)
+	if err != nil {
+		return time.Time{}, src, err
+	}
+	if len(resto) < 4 {
+		return time.Time{}, src, ErrDatosInsuficientes
+	}
+	return time.Unix(segundos, int64(binary.BigEndian.Uint32(resto))).UTC(), resto[4:], nil
+}
+
+// Par es una tupla de dos componentes, que se ordena primero por Primero y luego por Segundo
+type Par[A, B any] struct {
+	Primero A
+	Segundo B
+}
+
+// Terna es una tupla de tres componentes, que se ordena lexicográficamente
+type Terna[A, B, C any] struct {
+	Primero A
+	Segundo B
+	Tercero C
+}
+
+type codificadorPar[A, B any] struct {
+	primero Codificador[A]
+	segundo Codificador[B]
+}
+
+// CodificadorPar codifica un Par concatenando las codificaciones de sus componentes
+func CodificadorPar[A, B any](primero Codificador[A], segundo Codificador[B]) Codificador[Par[A, B]] {
+	return codificadorPar[A, B]{primero: primero, segundo: segundo}
+}
+
+func (c codificadorPar[A, B]) Codificar(dst []byte, valor Par[A, B]) ([]byte, error) {
+	dst, err := c.primero.Codificar(dst, valor.Primero)
+	if err != nil {
+		return dst, fmt.Errorf("primer componente: %w", err)
+	}
+	dst, err = c.segundo.Codificar(dst, valor.Segundo)
+	if err != nil {
+		return dst, fmt.Errorf("segundo componente: %w", err)
+	}
+	return dst, nil
+}
+
+func (c codificadorPar[A, B]) Decodificar(src []byte) (Par[A, B], []byte, error) {
+	var par Par[A, B]
+	var err error
+	resto := src
+	if par.Primero, resto, err = c.primero.Decodificar(resto); err != nil {
+		return par, src, fmt.Errorf("primer componente: %w", err)
+	}
+	if par.Segundo, resto, err = c.segundo.Decodificar(resto); err != nil {
+		return par, src, fmt.Errorf("segundo componente: %w", err)
+	}
+	return par, resto, nil
+}
+
+type codificadorTerna[A, B, C any] struct {
+	par     Codificador[Par[A, B]]
+	tercero Codificador[C]
+}
+
+// CodificadorTerna codifica una Terna concatenando las codificaciones de sus componentes
+func CodificadorTerna[A, B, C any](primero Codificador[A], segundo Codificador[B], tercero Codificador[C]) Codificador[Terna[A, B, C]] {
+	return codificadorTerna[A, B, C]{par: CodificadorPar(primero, segundo), tercero: tercero}
+}
+
+func (c codificadorTerna[A, B, C]) Codificar(dst []byte, valor Terna[A, B, C]) ([]byte, error) {
+	dst, err := c.par.Codificar(dst, Par[A, B]{Primero: valor.Primero, Segundo: valor.Segundo})
+	if err != nil {
+		return dst, err
+	}
+	dst, err = c.tercero.Codificar(dst, valor.Tercero)
+	if err != nil {
+		return dst, fmt.Errorf("tercer componente: %w", err)
+	}
+	return dst, nil
+}
+
+func (c codificadorTerna[A, B, C]) Decodificar(src []byte) (Terna[A, B, C], []byte, error) {
+	var terna Terna[A, B, C]
+	par, resto, err := c.par.Decodificar(src)
+	if err != nil {
+		return terna, src, err
+	}
+	terna.Primero, terna.Segundo = par.Primero, par.Segundo
+	if terna.Tercero, resto, err = c.tercero.Decodificar(resto); err != nil {
+		return terna, src, fmt.Errorf("tercer componente: %w", err)
+	}
+	return terna, resto, nil
+}
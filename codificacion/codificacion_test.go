@@ -0,0 +1,174 @@
+package codificacion_test
+
+import (
+	"bytes"
+	"cmp"
+	"math"
+	"math/rand"
+	"strings"
+	"tdas/diccionario/codificacion"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// verificarOrden codifica todos los valores y comprueba que el orden de los bytes coincida con comparar, y que
+// decodificar devuelva un valor equivalente sin dejar bytes sobrantes
+func verificarOrden[T any](t *testing.T, codificador codificacion.Codificador[T], valores []T, comparar func(T, T) int) {
+	codificados := make([][]byte, len(valores))
+	for i, valor := range valores {
+		var err error
+		codificados[i], err = codificador.Codificar(nil, valor)
+		require.NoError(t, err)
+		decodificado, resto, err := codificador.Decodificar(codificados[i])
+		require.NoError(t, err)
+		require.Empty(t, resto)
+		require.EqualValues(t, 0, comparar(valor, decodificado))
+	}
+	for i := range valores {
+		for j := range valores {
+			require.EqualValues(t, cmp.Compare(comparar(valores[i], valores[j]), 0),
+				bytes.Compare(codificados[i], codificados[j]))
+		}
+	}
+}
+
+func TestEnteros(t *testing.T) {
+	t.Log("Los enteros con signo se ordenan por bytes igual que por valor, incluyendo los extremos")
+	valores := []int64{math.MinInt64, math.MinInt64 + 1, -1 << 40, -256, -1, 0, 1, 255, 1 << 40, math.MaxInt64}
+	generador := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		valores = append(valores, generador.Int63()-generador.Int63())
+	}
+	verificarOrden(t, codificacion.Entero[int64](), valores, cmp.Compare[int64])
+	verificarOrden(t, codificacion.Entero[int8](), []int8{-128, -1, 0, 1, 127}, cmp.Compare[int8])
+}
+
+func TestFlotantes(t *testing.T) {
+	t.Log("Los flotantes se ordenan por bytes igual que con cmp.Compare, con NaN antes que -Inf")
+	valores := []float64{math.Inf(-1), -math.MaxFloat64, -1.5, -math.SmallestNonzeroFloat64, 0,
+		math.SmallestNonzeroFloat64, 1, 1.5, math.MaxFloat64, math.Inf(1), math.NaN()}
+	codificador := codificacion.Flotante(codificacion.NaNPrimero, codificacion.CeroNegativoIgual)
+	verificarOrden(t, codificador, valores, cmp.Compare[float64])
+
+	cero, _ := codificador.Codificar(nil, 0)
+	ceroNegativo, _ := codificador.Codificar(nil, math.Copysign(0, -1))
+	require.EqualValues(t, cero, ceroNegativo)
+}
+
+func TestFlotantesPoliticas(t *testing.T) {
+	t.Log("Las políticas permiten ubicar NaN al final, rechazarlo, y distinguir -0 de +0")
+	ultimo := codificacion.Flotante(codificacion.NaNUltimo, codificacion.CeroNegativoMenor)
+	nan, err := ultimo.Codificar(nil, math.NaN())
+	require.NoError(t, err)
+	infinito, _ := ultimo.Codificar(nil, math.Inf(1))
+	require.Greater(t, bytes.Compare(nan, infinito), 0)
+	decodificado, _, err := ultimo.Decodificar(nan)
+	require.NoError(t, err)
+	require.True(t, math.IsNaN(decodificado))
+
+	cero, _ := ultimo.Codificar(nil, 0)
+	ceroNegativo, _ := ultimo.Codificar(nil, math.Copysign(0, -1))
+	menosUno, _ := ultimo.Codificar(nil, -1)
+	require.Less(t, bytes.Compare(menosUno, ceroNegativo), 0)
+	require.Less(t, bytes.Compare(ceroNegativo, cero), 0)
+	decodificado, _, _ = ultimo.Decodificar(ceroNegativo)
+	require.True(t, math.Signbit(decodificado))
+
+	invalido := codificacion.Flotante(codificacion.NaNInvalido, codificacion.CeroNegativoIgual)
+	_, err = invalido.Codificar(nil, math.NaN())
+	require.ErrorIs(t, err, codificacion.ErrNaN)
+}
+
+func TestCadenas(t *testing.T) {
+	t.Log("Las cadenas se ordenan como strings.Compare aun con bytes nulos y prefijos")
+	valores := []string{"", "\x00", "\x00\x00", "\x00\x01", "\x01", "a", "a\x00", "a\x00b", "a\x01", "ab", "b",
+		"\xff", "\xff\x00", "ñandú"}
+	verificarOrden(t, codificacion.Cadena(), valores, strings.Compare)
+
+	_, _, err := codificacion.Cadena().Decodificar([]byte("abc"))
+	require.ErrorIs(t, err, codificacion.ErrDatosInsuficientes)
+	_, _, err = codificacion.Cadena().Decodificar([]byte("abc\x00"))
+	require.ErrorIs(t, err, codificacion.ErrDatosInsuficientes)
+	_, _, err = codificacion.Cadena().Decodificar([]byte("abc\x00\x02"))
+	require.ErrorIs(t, err, codificacion.ErrDatosInvalidos)
+}
+
+func TestTiempos(t *testing.T) {
+	t.Log("Los instantes se ordenan cronológicamente, antes y después de la época Unix, sin importar la zona")
+	base := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	zona := time.FixedZone("ART", -3*60*60)
+	valores := []time.Time{
+		time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Unix(-1, 999_999_999),
+		time.Unix(0, 0),
+		base.Add(-time.Nanosecond),
+		base.In(zona),
+		base.Add(time.Nanosecond),
+		time.Date(9999, 12, 31, 23, 59, 59, 999_999_999, time.UTC),
+	}
+	verificarOrden(t, codificacion.Tiempo(), valores, func(a, b time.Time) int { return a.Compare(b) })
+}
+
+func TestTuplas(t *testing.T) {
+	t.Log("Las tuplas se ordenan lexicográficamente por componente, aunque una cadena sea prefijo de otra")
+	type par = codificacion.Par[string, int64]
+	valores := []par{}
+	for _, nombre := range []string{"", "a", "a\x00", "ab", "b"} {
+		for _, numero := range []int64{math.MinInt64, -5, 0, 5, math.MaxInt64} {
+			valores = append(valores, par{Primero: nombre, Segundo: numero})
+		}
+	}
+	compararPares := func(a, b par) int {
+		return cmp.Or(strings.Compare(a.Primero, b.Primero), cmp.Compare(a.Segundo, b.Segundo))
+	}
+	verificarOrden(t, codificacion.CodificadorPar(codificacion.Cadena(), codificacion.Entero[int64]()), valores, compararPares)
+
+	// Los componentes que empiezan con 0xFF no deben confundirse con un 0x00 escapado de la cadena anterior
+	type parCadenas = codificacion.Par[string, string]
+	cadenas := []parCadenas{}
+	for _, primero := range []string{"a", "a\x00", "a\x00\xff", "a\xff"} {
+		for _, segundo := range []string{"", "\x00", "\xff", "\xff\x01", "\xff\xff"} {
+			cadenas = append(cadenas, parCadenas{Primero: primero, Segundo: segundo})
+		}
+	}
+	compararCadenas := func(a, b parCadenas) int {
+		return cmp.Or(strings.Compare(a.Primero, b.Primero), strings.Compare(a.Segundo, b.Segundo))
+	}
+	verificarOrden(t, codificacion.CodificadorPar(codificacion.Cadena(), codificacion.Cadena()), cadenas, compararCadenas)
+
+	type parFlotante = codificacion.Par[string, float64]
+	flotantes := []parFlotante{}
+	for _, nombre := range []string{"x", "x\x00"} {
+		for _, numero := range []float64{math.Inf(-1), 0, math.MaxFloat64, math.Inf(1)} {
+			flotantes = append(flotantes, parFlotante{Primero: nombre, Segundo: numero})
+		}
+	}
+	verificarOrden(t, codificacion.CodificadorPar(codificacion.Cadena(),
+		codificacion.Flotante(codificacion.NaNInvalido, codificacion.CeroNegativoIgual)), flotantes,
+		func(a, b parFlotante) int {
+			return cmp.Or(strings.Compare(a.Primero, b.Primero), cmp.Compare(a.Segundo, b.Segundo))
+		})
+
+	type terna = codificacion.Terna[int64, string, float64]
+	codificadorTerna := codificacion.CodificadorTerna(codificacion.Entero[int64](), codificacion.Cadena(),
+		codificacion.Flotante(codificacion.NaNInvalido, codificacion.CeroNegativoIgual))
+	ternas := []terna{
+		{Primero: 1, Segundo: "x", Tercero: 2.5},
+		{Primero: -1, Segundo: "z", Tercero: 0},
+		{Primero: 1, Segundo: "x", Tercero: -2.5},
+		{Primero: 1, Segundo: "", Tercero: 100},
+	}
+	compararTernas := func(a, b terna) int {
+		return cmp.Or(cmp.Compare(a.Primero, b.Primero), strings.Compare(a.Segundo, b.Segundo), cmp.Compare(a.Tercero, b.Tercero))
+	}
+	verificarOrden(t, codificadorTerna, ternas, compararTernas)
+
+	_, err := codificadorTerna.Codificar(nil, terna{Primero: 1, Segundo: "x", Tercero: math.NaN()})
+	require.ErrorIs(t, err, codificacion.ErrNaN)
+
+	codificada, _ := codificadorTerna.Codificar(nil, ternas[0])
+	_, _, err = codificadorTerna.Decodificar(codificada[:len(codificada)-1])
+	require.ErrorIs(t, err, codificacion.ErrDatosInsuficientes)
+}
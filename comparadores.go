@@ -0,0 +1,163 @@
+package diccionario
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Invertir devuelve una función de comparación con el orden inverso al de cmp
+func Invertir[K any](cmp func(K, K) int) func(K, K) int {
+	return func(a, b K) int {
+		return cmp(b, a)
+	}
+}
+
+// Lexicografico devuelve una función de comparación que compara con cada función en orden, pasando a la
+// siguiente sólo si la anterior considera iguales a los elementos. Junto con PorCampo permite comparar
+// estructuras por varios de sus campos
+func Lexicografico[K any](cmps ...func(K, K) int) func(K, K) int {
+	return func(a, b K) int {
+		for _, cmp := range cmps {
+			if res := cmp(a, b); res != 0 {
+				return res
+			}
+		}
+		return 0
+	}
+}
+
+// PorCampo devuelve una función de comparación que compara el valor que extraer obtiene de cada elemento
+func PorCampo[K any, C any](extraer func(K) C, cmp func(C, C) int) func(K, K) int {
+	return func(a, b K) int {
+		return cmp(extraer(a), extraer(b))
+	}
+}
+
+// NilPrimero devuelve una función de comparación de punteros que ubica a nil antes que cualquier otro puntero,
+// y compara los punteros no nil según el valor al que apuntan. Dos punteros distintos a valores iguales son la
+// misma clave para el diccionario
+func NilPrimero[T any](cmp func(T, T) int) func(*T, *T) int {
+	return compararPunteros(cmp, -1)
+}
+
+// NilUltimo es como NilPrimero, pero ubica a nil después de cualquier otro puntero
+func NilUltimo[T any](cmp func(T, T) int) func(*T, *T) int {
+	return compararPunteros(cmp, 1)
+}
+
+// compararPunteros ubica a nil según el signo de ordenNil respecto de los punteros no nil
+func compararPunteros[T any](cmp func(T, T) int, ordenNil int) func(*T, *T) int {
+	return func(a, b *T) int {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return ordenNil
+		case b == nil:
+			return -ordenNil
+		}
+		return cmp(*a, *b)
+	}
+}
+
+// CompararSinMayusculas compara cadenas ignorando mayúsculas y minúsculas, runa por runa. Las cadenas que sólo
+// difieren en mayúsculas son iguales, por lo que en un diccionario son la misma clave. Los bytes que no son
+// UTF-8 válido se comparan por su valor, después de todas las runas
+func CompararSinMayusculas(a, b string) int {
+	for a != "" && b != "" {
+		runaA, tamA := runaSinMayusculas(a)
+		runaB, tamB := runaSinMayusculas(b)
+		if res := compararRunas(runaA, runaB); res != 0 {
+			return res
+		}
+		a, b = a[tamA:], b[tamB:]
+	}
+	return compararRunas(utf8.RuneCountInString(a), utf8.RuneCountInString(b))
+}
+
+// runaSinMayusculas decodifica la primera runa de s en minúscula. Un byte inválido se decodifica como
+// utf8.RuneError, por lo que se reemplaza por un valor que lo distingue de los demás bytes inválidos y de las
+// runas válidas, incluida la propia U+FFFD
+func runaSinMayusculas(s string) (rune, int) {
+	runa, tam := utf8.DecodeRuneInString(s)
+	if runa == utf8.RuneError && tam == 1 {
+		return utf8.MaxRune + 1 + rune(s[0]), tam
+	}
+	return unicode.ToLower(runa), tam
+}
+
+func compararRunas[T rune | int](a, b T) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+// letrasAcentuadas asocia cada letra minúscula con diacríticos a su letra base
+var letrasAcentuadas = map[rune]rune{
+	'á': 'a', 'à': 'a', 'â': 'a', 'ä': 'a', 'ã': 'a', 'å': 'a',
+	'é': 'e', 'è': 'e', 'ê': 'e', 'ë': 'e',
+	'í': 'i', 'ì': 'i', 'î': 'i', 'ï': 'i',
+	'ó': 'o', 'ò': 'o', 'ô': 'o', 'ö': 'o', 'õ': 'o',
+	'ú': 'u', 'ù': 'u', 'û': 'u', 'ü': 'u',
+	'ç': 'c', 'ý': 'y', 'ÿ': 'y',
+}
+
+// pesoEspaniol devuelve el peso primario de la runa (letra base sin acento ni mayúscula, con la ñ entre la n y
+// la o) y si tiene acento
+func pesoEspaniol(r rune) (int, bool) {
+	r = unicode.ToLower(r)
+	if r == 'ñ' {
+		return 2*int('n') + 1, false
+	}
+	if base, ok := letrasAcentuadas[r]; ok {
+		return 2 * int(base), true
+	}
+	return 2 * int(r), false
+}
+
+// CompararEspaniol compara cadenas según el orden alfabético del español: primero por letra ignorando acentos
+// y mayúsculas, con la ñ como letra propia entre la n y la o; a igualdad, la palabra sin acento va antes que la
+// acentuada, y luego la minúscula antes que la mayúscula. Las cadenas distintas nunca se consideran iguales.
+//
+// Para otros idiomas, la función CompareString de un collate.Collator de golang.org/x/text/collate puede
+// usarse directamente como función de comparación.
+func CompararEspaniol(a, b string) int {
+	runasA, runasB := []rune(a), []rune(b)
+	largo := min(len(runasA), len(runasB))
+	acentos, mayusculas := 0, 0
+	for i := 0; i < largo; i++ {
+		pesoA, acentoA := pesoEspaniol(runasA[i])
+		pesoB, acentoB := pesoEspaniol(runasB[i])
+		if pesoA != pesoB {
+			return compararRunas(pesoA, pesoB)
+		}
+		if acentos == 0 {
+			acentos = compararRunas(boolAInt(acentoA), boolAInt(acentoB))
+		}
+		if mayusculas == 0 {
+			mayusculas = compararRunas(boolAInt(unicode.IsUpper(runasA[i])), boolAInt(unicode.IsUpper(runasB[i])))
+		}
+	}
+	if len(runasA) != len(runasB) {
+		return compararRunas(len(runasA), len(runasB))
+	}
+	if acentos != 0 {
+		return acentos
+	}
+	if mayusculas != 0 {
+		return mayusculas
+	}
+	return strings.Compare(a, b)
+}
+
+func boolAInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
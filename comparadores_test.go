@@ -0,0 +1,114 @@
+package diccionario_test
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	TDADiccionario "tdas/diccionario"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComparadoresInvertir(t *testing.T) {
+	t.Log("Un diccionario con el comparador invertido itera de mayor a menor")
+	dic := TDADiccionario.CrearABB[int, int](TDADiccionario.Invertir(cmp.Compare[int]))
+	for _, clave := range []int{3, 1, 4, 5, 9, 2, 6} {
+		dic.Guardar(clave, clave)
+	}
+	claves := []int{}
+	dic.Iterar(func(clave int, _ int) bool {
+		claves = append(claves, clave)
+		return true
+	})
+	require.EqualValues(t, []int{9, 6, 5, 4, 3, 2, 1}, claves)
+}
+
+func TestComparadoresStructs(t *testing.T) {
+	t.Log("Lexicografico y PorCampo reemplazan a un comparador escrito a mano para claves struct")
+	type persona struct {
+		apellido string
+		nombre   string
+		edad     int
+	}
+	compararPersonas := TDADiccionario.Lexicografico(
+		TDADiccionario.PorCampo(func(p persona) string { return p.apellido }, strings.Compare),
+		TDADiccionario.PorCampo(func(p persona) string { return p.nombre }, strings.Compare),
+		TDADiccionario.Invertir(TDADiccionario.PorCampo(func(p persona) int { return p.edad }, cmp.Compare[int])),
+	)
+	dic := TDADiccionario.CrearABB[persona, int](compararPersonas)
+	personas := []persona{
+		{"Perez", "Juan", 30},
+		{"Gomez", "Ana", 25},
+		{"Perez", "Ana", 40},
+		{"Perez", "Juan", 50},
+		{"Gomez", "Ana", 25},
+	}
+	for i, p := range personas {
+		dic.Guardar(p, i)
+	}
+	require.EqualValues(t, 4, dic.Cantidad())
+	require.EqualValues(t, 4, dic.Obtener(persona{"Gomez", "Ana", 25}))
+
+	orden := []persona{}
+	dic.Iterar(func(p persona, _ int) bool {
+		orden = append(orden, p)
+		return true
+	})
+	require.EqualValues(t, []persona{{"Gomez", "Ana", 25}, {"Perez", "Ana", 40}, {"Perez", "Juan", 50}, {"Perez", "Juan", 30}}, orden)
+	require.EqualValues(t, 0, TDADiccionario.Lexicografico[int]()(1, 2))
+}
+
+func TestComparadoresPunteros(t *testing.T) {
+	t.Log("NilPrimero y NilUltimo ubican a nil en un extremo y comparan el resto por valor")
+	uno, dos, otroUno := 1, 2, 1
+	valores := []*int{&dos, nil, &uno}
+
+	slices.SortFunc(valores, TDADiccionario.NilPrimero(cmp.Compare[int]))
+	require.Nil(t, valores[0])
+	require.EqualValues(t, 1, *valores[1])
+	require.EqualValues(t, 2, *valores[2])
+
+	slices.SortFunc(valores, TDADiccionario.NilUltimo(cmp.Compare[int]))
+	require.EqualValues(t, 1, *valores[0])
+	require.EqualValues(t, 2, *valores[1])
+	require.Nil(t, valores[2])
+
+	dic := TDADiccionario.CrearABB[*int, string](TDADiccionario.NilUltimo(cmp.Compare[int]))
+	dic.Guardar(nil, "nil")
+	dic.Guardar(&uno, "uno")
+	require.True(t, dic.Pertenece(&otroUno))
+	require.EqualValues(t, "nil", dic.Obtener(nil))
+}
+
+func TestComparadoresSinMayusculas(t *testing.T) {
+	t.Log("CompararSinMayusculas considera iguales a las cadenas que sólo difieren en mayúsculas")
+	require.EqualValues(t, 0, TDADiccionario.CompararSinMayusculas("Hola", "hOLA"))
+	require.EqualValues(t, 0, TDADiccionario.CompararSinMayusculas("ÑANDÚ", "ñandú"))
+	require.EqualValues(t, -1, TDADiccionario.CompararSinMayusculas("abc", "ABD"))
+	require.EqualValues(t, -1, TDADiccionario.CompararSinMayusculas("AB", "abc"))
+	require.EqualValues(t, 1, TDADiccionario.CompararSinMayusculas("b", "A"))
+
+	dic := TDADiccionario.CrearABB[string, int](TDADiccionario.CompararSinMayusculas)
+	dic.Guardar("Casa", 1)
+	dic.Guardar("CASA", 2)
+	require.EqualValues(t, 1, dic.Cantidad())
+	require.EqualValues(t, 2, dic.Obtener("casa"))
+
+	// Los bytes inválidos no se confunden entre sí ni con U+FFFD
+	require.EqualValues(t, 1, TDADiccionario.CompararSinMayusculas("\xff", "\xfe"))
+	require.EqualValues(t, 1, TDADiccionario.CompararSinMayusculas("\xfe", "\uFFFD"))
+	require.EqualValues(t, 0, TDADiccionario.CompararSinMayusculas("A\xff", "a\xff"))
+	dic.Guardar("\xff", 3)
+	dic.Guardar("\xfe", 4)
+	require.EqualValues(t, 3, dic.Obtener("\xff"))
+	require.EqualValues(t, 3, dic.Cantidad())
+}
+
+func TestComparadoresEspaniol(t *testing.T) {
+	t.Log("CompararEspaniol ordena alfabéticamente ignorando acentos y con la ñ entre la n y la o")
+	palabras := []string{"ñu", "oso", "Nube", "árbol", "nube", "arbol", "Árbol", "nudo", "zorro", "ábaco", "canción", "cancion"}
+	slices.SortFunc(palabras, TDADiccionario.CompararEspaniol)
+	require.EqualValues(t, []string{"ábaco", "arbol", "árbol", "Árbol", "cancion", "canción", "nube", "Nube", "nudo", "ñu", "oso", "zorro"}, palabras)
+	require.EqualValues(t, 0, TDADiccionario.CompararEspaniol("pingüino", "pingüino"))
+}
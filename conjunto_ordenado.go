@@ -0,0 +1,286 @@
+package diccionario
+
+import "slices"
+
+// ConjuntoOrdenado es un conjunto de elementos que se recorren de menor a mayor según la función de comparación
+type ConjuntoOrdenado[K comparable] interface {
+	// Agregar agrega el elemento al conjunto. Si ya pertenecía, no hace nada
+	Agregar(elem K)
+
+	// Pertenece determina si el elemento pertenece al conjunto
+	Pertenece(elem K) bool
+
+	// Borrar quita el elemento del conjunto. Si no pertenece, entra en pánico con un mensaje
+	// 'El elemento no pertenece al conjunto'
+	Borrar(elem K)
+
+	// Cantidad devuelve la cantidad de elementos del conjunto
+	Cantidad() int
+
+	// Iterar visita los elementos de menor a mayor, mientras visitar devuelva true
+	Iterar(visitar func(elem K) bool)
+
+	// IterarRango es como Iterar, pero sólo con los elementos comprendidos en el rango indicado
+	IterarRango(desde *K, hasta *K, visitar func(elem K) bool)
+
+	// Iterador devuelve un IterConjunto que recorre los elementos de menor a mayor
+	Iterador() IterConjunto[K]
+
+	// IteradorRango es como Iterador, pero sólo con los elementos comprendidos en el rango indicado
+	IteradorRango(desde *K, hasta *K) IterConjunto[K]
+
+	// Minimo devuelve el menor elemento. Si el conjunto está vacío, entra en pánico con un mensaje
+	// 'El conjunto esta vacio'
+	Minimo() K
+
+	// Maximo devuelve el mayor elemento. Si el conjunto está vacío, entra en pánico con un mensaje
+	// 'El conjunto esta vacio'
+	Maximo() K
+
+	// Piso devuelve el mayor elemento menor o igual al indicado, y false si no hay ninguno
+	Piso(elem K) (K, bool)
+
+	// Techo devuelve el menor elemento mayor o igual al indicado, y false si no hay ninguno
+	Techo(elem K) (K, bool)
+
+	// Union devuelve un conjunto nuevo con los elementos que pertenecen a alguno de los dos conjuntos
+	Union(otro ConjuntoOrdenado[K]) ConjuntoOrdenado[K]
+
+	// Interseccion devuelve un conjunto nuevo con los elementos que pertenecen a ambos conjuntos
+	Interseccion(otro ConjuntoOrdenado[K]) ConjuntoOrdenado[K]
+
+	// Diferencia devuelve un conjunto nuevo con los elementos que pertenecen al conjunto pero no a otro
+	Diferencia(otro ConjuntoOrdenado[K]) ConjuntoOrdenado[K]
+
+	// EsSubconjunto determina si todos los elementos del conjunto pertenecen a otro
+	EsSubconjunto(otro ConjuntoOrdenado[K]) bool
+}
+
+// IterConjunto es un iterador externo sobre los elementos de un ConjuntoOrdenado
+type IterConjunto[K comparable] interface {
+	// HaySiguiente devuelve si hay más elementos para ver
+	HaySiguiente() bool
+
+	// VerActual devuelve el elemento actual. Si ya se recorrieron todos, entra en pánico con un mensaje
+	// 'El iterador termino de iterar'
+	VerActual() K
+
+	// Siguiente avanza al próximo elemento. Si ya se recorrieron todos, entra en pánico con un mensaje
+	// 'El iterador termino de iterar'
+	Siguiente()
+}
+
+// conjuntoABB es un abb cuyos datos no ocupan memoria
+type conjuntoABB[K comparable] struct {
+	arbol *abb[K, struct{}]
+}
+
+// CrearConjuntoOrdenado crea un conjunto ordenado implementado sobre un abb. Las operaciones entre conjuntos
+// recorren ambos en orden, con costo O(n + m), y el conjunto resultante usa la función de comparación del
+// receptor y queda balanceado. Si otro no se recorre en el orden del receptor (por ejemplo, porque usa otra
+// función de comparación), las operaciones siguen siendo correctas pero consultan con Pertenece a cada conjunto
+// por los elementos del otro, con costo O((n + m) log(n + m))
+func CrearConjuntoOrdenado[K comparable](cmp func(K, K) int) ConjuntoOrdenado[K] {
+	return &conjuntoABB[K]{arbol: &abb[K, struct{}]{cmp: cmp}}
+}
+
+func (c *conjuntoABB[K]) Agregar(elem K) {
+	c.arbol.Guardar(elem, struct{}{})
+}
+
+func (c *conjuntoABB[K]) Pertenece(elem K) bool {
+	return c.arbol.Pertenece(elem)
+}
+
+func (c *conjuntoABB[K]) Borrar(elem K) {
+	if !c.arbol.Pertenece(elem) {
+		panic("El elemento no pertenece al conjunto")
+	}
+	c.arbol.Borrar(elem)
+}
+
+func (c *conjuntoABB[K]) Cantidad() int {
+	return c.arbol.Cantidad()
+}
+
+func (c *conjuntoABB[K]) Iterar(visitar func(K) bool) {
+	c.arbol.Iterar(func(elem K, _ struct{}) bool { return visitar(elem) })
+}
+
+func (c *conjuntoABB[K]) IterarRango(desde *K, hasta *K, visitar func(K) bool) {
+	c.arbol.IterarRango(desde, hasta, func(elem K, _ struct{}) bool { return visitar(elem) })
+}
+
+// iteradorConjunto adapta el iterador del abb ignorando los datos
+type iteradorConjunto[K comparable] struct {
+	iter IterDiccionario[K, struct{}]
+}
+
+func (c *conjuntoABB[K]) Iterador() IterConjunto[K] {
+	return &iteradorConjunto[K]{iter: c.arbol.Iterador()}
+}
+
+func (c *conjuntoABB[K]) IteradorRango(desde *K, hasta *K) IterConjunto[K] {
+	return &iteradorConjunto[K]{iter: c.arbol.IteradorRango(desde, hasta)}
+}
+
+func (iter *iteradorConjunto[K]) HaySiguiente() bool {
+	return iter.iter.HaySiguiente()
+}
+
+func (iter *iteradorConjunto[K]) VerActual() K {
+	elem, _ := iter.iter.VerActual()
+	return elem
+}
+
+func (iter *iteradorConjunto[K]) Siguiente() {
+	iter.iter.Siguiente()
+}
+
+func (c *conjuntoABB[K]) Minimo() K {
+	if c.arbol.raiz == nil {
+		panic("El conjunto esta vacio")
+	}
+	return c.arbol.buscarMin(c.arbol.raiz).clave
+}
+
+func (c *conjuntoABB[K]) Maximo() K {
+	if c.arbol.raiz == nil {
+		panic("El conjunto esta vacio")
+	}
+	n := c.arbol.raiz
+	for n.der != nil {
+		n = n.der
+	}
+	return n.clave
+}
+
+func (c *conjuntoABB[K]) Piso(elem K) (K, bool) {
+	return claveDeNodo(c.arbol.buscarPiso(elem))
+}
+
+func (c *conjuntoABB[K]) Techo(elem K) (K, bool) {
+	return claveDeNodo(c.arbol.buscarTecho(elem))
+}
+
+func claveDeNodo[K comparable, V any](n *nodoABB[K, V]) (K, bool) {
+	if n == nil {
+		var cero K
+		return cero, false
+	}
+	return n.clave, true
+}
+
+// combinar arma un conjunto balanceado con los elementos que sólo están en el receptor, en ambos o sólo en otro,
+// según lo indicado. Si otro se recorre en el orden del receptor, recorre ambos en orden a la vez
+func (c *conjuntoABB[K]) combinar(otro ConjuntoOrdenado[K], soloEste, ambos, soloOtro bool) ConjuntoOrdenado[K] {
+	var elems []K
+	if c.ordenadoComoEste(otro) {
+		elems = c.intercalar(otro, soloEste, ambos, soloOtro)
+	} else {
+		elems = c.consultar(otro, soloEste, ambos, soloOtro)
+	}
+	nodos := make([]*nodoABB[K, struct{}], len(elems))
+	for i, elem := range elems {
+		nodos[i] = &nodoABB[K, struct{}]{clave: elem}
+	}
+	resultado := &abb[K, struct{}]{raiz: armarBalanceado(nodos), cantidad: len(nodos), cmp: c.arbol.cmp}
+	return &conjuntoABB[K]{arbol: resultado}
+}
+
+// ordenadoComoEste indica si otro recorre sus elementos en orden estrictamente creciente según la función de
+// comparación del receptor
+func (c *conjuntoABB[K]) ordenadoComoEste(otro ConjuntoOrdenado[K]) bool {
+	ordenado, primero := true, true
+	var anterior K
+	otro.Iterar(func(elem K) bool {
+		ordenado = primero || c.arbol.cmp(anterior, elem) < 0
+		anterior, primero = elem, false
+		return ordenado
+	})
+	return ordenado
+}
+
+// intercalar recorre ambos conjuntos en orden a la vez, y devuelve en orden los elementos elegidos
+func (c *conjuntoABB[K]) intercalar(otro ConjuntoOrdenado[K], soloEste, ambos, soloOtro bool) []K {
+	var elems []K
+	agregar := func(elem K) {
+		elems = append(elems, elem)
+	}
+	iterEste, iterOtro := c.Iterador(), otro.Iterador()
+	for iterEste.HaySiguiente() || iterOtro.HaySiguiente() {
+		var cmp int
+		if !iterOtro.HaySiguiente() {
+			cmp = -1
+		} else if !iterEste.HaySiguiente() {
+			cmp = 1
+		} else {
+			cmp = c.arbol.cmp(iterEste.VerActual(), iterOtro.VerActual())
+		}
+		switch {
+		case cmp < 0:
+			if soloEste {
+				agregar(iterEste.VerActual())
+			}
+			iterEste.Siguiente()
+		case cmp > 0:
+			if soloOtro {
+				agregar(iterOtro.VerActual())
+			}
+			iterOtro.Siguiente()
+		default:
+			if ambos {
+				agregar(iterEste.VerActual())
+			}
+			iterEste.Siguiente()
+			iterOtro.Siguiente()
+		}
+	}
+	return elems
+}
+
+// consultar decide la pertenencia de cada elemento con Pertenece del otro conjunto, y devuelve los elementos
+// elegidos ordenados y sin repetidos según la función de comparación del receptor
+func (c *conjuntoABB[K]) consultar(otro ConjuntoOrdenado[K], soloEste, ambos, soloOtro bool) []K {
+	var elems []K
+	c.Iterar(func(elem K) bool {
+		if enOtro := otro.Pertenece(elem); (enOtro && ambos) || (!enOtro && soloEste) {
+			elems = append(elems, elem)
+		}
+		return true
+	})
+	if soloOtro {
+		otro.Iterar(func(elem K) bool {
+			if !c.Pertenece(elem) {
+				elems = append(elems, elem)
+			}
+			return true
+		})
+	}
+	slices.SortFunc(elems, c.arbol.cmp)
+	return slices.CompactFunc(elems, func(a, b K) bool { return c.arbol.cmp(a, b) == 0 })
+}
+
+func (c *conjuntoABB[K]) Union(otro ConjuntoOrdenado[K]) ConjuntoOrdenado[K] {
+	return c.combinar(otro, true, true, true)
+}
+
+func (c *conjuntoABB[K]) Interseccion(otro ConjuntoOrdenado[K]) ConjuntoOrdenado[K] {
+	return c.combinar(otro, false, true, false)
+}
+
+func (c *conjuntoABB[K]) Diferencia(otro ConjuntoOrdenado[K]) ConjuntoOrdenado[K] {
+	return c.combinar(otro, true, false, false)
+}
+
+func (c *conjuntoABB[K]) EsSubconjunto(otro ConjuntoOrdenado[K]) bool {
+	if c.Cantidad() > otro.Cantidad() {
+		return false
+	}
+	esSubconjunto := true
+	c.Iterar(func(elem K) bool {
+		esSubconjunto = otro.Pertenece(elem)
+		return esSubconjunto
+	})
+	return esSubconjunto
+}
@@ -0,0 +1,126 @@
+package diccionario_test
+
+import (
+	"cmp"
+	"strings"
+	TDADiccionario "tdas/diccionario"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func crearConjunto(elems ...int) TDADiccionario.ConjuntoOrdenado[int] {
+	conj := TDADiccionario.CrearConjuntoOrdenado[int](cmp.Compare)
+	for _, elem := range elems {
+		conj.Agregar(elem)
+	}
+	return conj
+}
+
+func elementosConjunto(conj TDADiccionario.ConjuntoOrdenado[int]) []int {
+	elems := []int{}
+	for iter := conj.Iterador(); iter.HaySiguiente(); iter.Siguiente() {
+		elems = append(elems, iter.VerActual())
+	}
+	return elems
+}
+
+func TestConjuntoOrdenadoVacio(t *testing.T) {
+	t.Log("Comprueba que un conjunto vacío no tiene elementos")
+	conj := TDADiccionario.CrearConjuntoOrdenado[string](strings.Compare)
+	require.EqualValues(t, 0, conj.Cantidad())
+	require.False(t, conj.Pertenece(""))
+	require.PanicsWithValue(t, "El elemento no pertenece al conjunto", func() { conj.Borrar("A") })
+	require.PanicsWithValue(t, "El conjunto esta vacio", func() { conj.Minimo() })
+	require.PanicsWithValue(t, "El conjunto esta vacio", func() { conj.Maximo() })
+	_, ok := conj.Piso("A")
+	require.False(t, ok)
+	require.PanicsWithValue(t, "El iterador termino de iterar", func() { conj.Iterador().VerActual() })
+}
+
+func TestConjuntoOrdenadoAgregarYBorrar(t *testing.T) {
+	t.Log("Agregar un elemento repetido no cambia el conjunto, y se recorre en orden")
+	conj := crearConjunto(5, 3, 8, 3, 1, 5)
+	require.EqualValues(t, 4, conj.Cantidad())
+	require.EqualValues(t, []int{1, 3, 5, 8}, elementosConjunto(conj))
+	conj.Borrar(3)
+	require.False(t, conj.Pertenece(3))
+	require.EqualValues(t, []int{1, 5, 8}, elementosConjunto(conj))
+
+	desde, hasta := 2, 8
+	enRango := []int{}
+	conj.IterarRango(&desde, &hasta, func(elem int) bool {
+		enRango = append(enRango, elem)
+		return true
+	})
+	require.EqualValues(t, []int{5, 8}, enRango)
+	iter := conj.IteradorRango(nil, &desde)
+	require.EqualValues(t, 1, iter.VerActual())
+	iter.Siguiente()
+	require.False(t, iter.HaySiguiente())
+}
+
+func TestConjuntoOrdenadoNavegacion(t *testing.T) {
+	t.Log("Minimo, Maximo, Piso y Techo encuentran los elementos extremos y vecinos")
+	conj := crearConjunto(50, 20, 80, 10, 30, 70, 90)
+	require.EqualValues(t, 10, conj.Minimo())
+	require.EqualValues(t, 90, conj.Maximo())
+
+	piso, ok := conj.Piso(35)
+	require.True(t, ok)
+	require.EqualValues(t, 30, piso)
+	piso, ok = conj.Piso(70)
+	require.True(t, ok)
+	require.EqualValues(t, 70, piso)
+	_, ok = conj.Piso(5)
+	require.False(t, ok)
+
+	techo, ok := conj.Techo(55)
+	require.True(t, ok)
+	require.EqualValues(t, 70, techo)
+	techo, ok = conj.Techo(10)
+	require.True(t, ok)
+	require.EqualValues(t, 10, techo)
+	_, ok = conj.Techo(91)
+	require.False(t, ok)
+}
+
+func TestConjuntoOrdenadoAlgebra(t *testing.T) {
+	t.Log("Unión, intersección, diferencia e inclusión devuelven conjuntos nuevos sin modificar los originales")
+	a := crearConjunto(1, 2, 3, 4, 5)
+	b := crearConjunto(4, 5, 6, 7)
+
+	require.EqualValues(t, []int{1, 2, 3, 4, 5, 6, 7}, elementosConjunto(a.Union(b)))
+	require.EqualValues(t, []int{4, 5}, elementosConjunto(a.Interseccion(b)))
+	require.EqualValues(t, []int{1, 2, 3}, elementosConjunto(a.Diferencia(b)))
+	require.EqualValues(t, []int{6, 7}, elementosConjunto(b.Diferencia(a)))
+	require.EqualValues(t, []int{1, 2, 3, 4, 5}, elementosConjunto(a))
+	require.EqualValues(t, 7, a.Union(b).Cantidad())
+
+	require.False(t, a.EsSubconjunto(b))
+	require.True(t, a.Interseccion(b).EsSubconjunto(b))
+	require.True(t, crearConjunto().EsSubconjunto(a))
+	require.True(t, a.EsSubconjunto(a))
+
+	// El resultado es un conjunto completamente funcional
+	union := a.Union(crearConjunto())
+	union.Agregar(0)
+	union.Borrar(3)
+	require.EqualValues(t, []int{0, 1, 2, 4, 5}, elementosConjunto(union))
+	require.EqualValues(t, 0, union.Minimo())
+}
+
+func TestConjuntoOrdenadoAlgebraConOtroComparador(t *testing.T) {
+	t.Log("Las operaciones son correctas aunque el otro conjunto se recorra en otro orden")
+	a := crearConjunto(1, 2, 3, 4, 5)
+	b := TDADiccionario.CrearConjuntoOrdenado[int](func(x, y int) int { return cmp.Compare(y, x) })
+	for _, elem := range []int{4, 5, 6, 7} {
+		b.Agregar(elem)
+	}
+
+	require.EqualValues(t, []int{1, 2, 3, 4, 5, 6, 7}, elementosConjunto(a.Union(b)))
+	require.EqualValues(t, []int{4, 5}, elementosConjunto(a.Interseccion(b)))
+	require.EqualValues(t, []int{1, 2, 3}, elementosConjunto(a.Diferencia(b)))
+	require.EqualValues(t, []int{7, 6}, elementosConjunto(b.Diferencia(a)))
+	require.EqualValues(t, []int{7, 6, 5, 4, 3, 2, 1}, elementosConjunto(b.Union(a)))
+}
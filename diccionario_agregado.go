@@ -0,0 +1,95 @@
+package diccionario
+
+// Monoide define cómo combinar datos: Combinar debe ser asociativa y Neutro su elemento neutro. No hace falta
+// que sea conmutativa, ya que los datos siempre se combinan en orden de claves
+type Monoide[V any] struct {
+	Neutro   V
+	Combinar func(V, V) V
+}
+
+// DiccionarioAgregado es un DiccionarioOrdenado que puede combinar los datos de un rango de claves sin
+// recorrerlo
+type DiccionarioAgregado[K comparable, V any] interface {
+	DiccionarioOrdenado[K, V]
+	Visualizable[K, V]
+
+	// Agregar devuelve la combinación, en orden de claves, de los datos cuyas claves se encuentran en el rango
+	// indicado (con los mismos límites que IterarRango). Si el rango no tiene claves, devuelve el neutro
+	Agregar(desde *K, hasta *K) V
+}
+
+// diccionarioAgregado es un AVL cuyo aumento es la combinación de todos los datos del subárbol
+type diccionarioAgregado[K comparable, V any] struct {
+	*avl[K, V, V]
+	monoide Monoide[V]
+}
+
+// CrearDiccionarioAgregado crea un diccionario ordenado balanceado cuyas operaciones cuestan O(log n), incluido
+// Agregar, suponiendo que Combinar cuesta O(1)
+func CrearDiccionarioAgregado[K comparable, V any](cmp func(K, K) int, monoide Monoide[V]) DiccionarioAgregado[K, V] {
+	d := &diccionarioAgregado[K, V]{monoide: monoide}
+	d.avl = &avl[K, V, V]{cmp: cmp, aumentar: d.combinarSubarbol}
+	return d
+}
+
+func (d *diccionarioAgregado[K, V]) agregado(n *nodoAVL[K, V, V]) V {
+	if n == nil {
+		return d.monoide.Neutro
+	}
+	return n.aumento
+}
+
+func (d *diccionarioAgregado[K, V]) combinarSubarbol(n *nodoAVL[K, V, V]) V {
+	return d.monoide.Combinar(d.monoide.Combinar(d.agregado(n.izq), n.dato), d.agregado(n.der))
+}
+
+// Agregar baja hasta el primer nodo dentro del rango y desde ahí sigue dos caminos, uno hacia cada límite,
+// usando el agregado completo de los subárboles que quedan enteros dentro del rango
+func (d *diccionarioAgregado[K, V]) Agregar(desde *K, hasta *K) V {
+	n := d.raiz
+	for n != nil {
+		if desde != nil && d.cmp(n.clave, *desde) < 0 {
+			n = n.der
+		} else if hasta != nil && d.cmp(n.clave, *hasta) > 0 {
+			n = n.izq
+		} else {
+			break
+		}
+	}
+	if n == nil {
+		return d.monoide.Neutro
+	}
+	izq := d.agregarDesde(n.izq, desde)
+	der := d.agregarHasta(n.der, hasta)
+	return d.monoide.Combinar(d.monoide.Combinar(izq, n.dato), der)
+}
+
+// agregarDesde combina los datos del subárbol con clave mayor o igual a desde
+func (d *diccionarioAgregado[K, V]) agregarDesde(n *nodoAVL[K, V, V], desde *K) V {
+	resultado := d.monoide.Neutro
+	for n != nil {
+		if desde != nil && d.cmp(n.clave, *desde) < 0 {
+			n = n.der
+			continue
+		}
+		// El nodo y su subárbol derecho están enteros en el rango, y se combinan a la derecha de lo que
+		// falta del subárbol izquierdo
+		resultado = d.monoide.Combinar(d.monoide.Combinar(n.dato, d.agregado(n.der)), resultado)
+		n = n.izq
+	}
+	return resultado
+}
+
+// agregarHasta combina los datos del subárbol con clave menor o igual a hasta
+func (d *diccionarioAgregado[K, V]) agregarHasta(n *nodoAVL[K, V, V], hasta *K) V {
+	resultado := d.monoide.Neutro
+	for n != nil {
+		if hasta != nil && d.cmp(n.clave, *hasta) > 0 {
+			n = n.izq
+			continue
+		}
+		resultado = d.monoide.Combinar(resultado, d.monoide.Combinar(d.agregado(n.izq), n.dato))
+		n = n.der
+	}
+	return resultado
+}
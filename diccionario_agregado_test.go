@@ -0,0 +1,67 @@
+package diccionario_test
+
+import (
+	"cmp"
+	"math"
+	"math/rand"
+	TDADiccionario "tdas/diccionario"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var monoideSuma = TDADiccionario.Monoide[int]{Neutro: 0, Combinar: func(a, b int) int { return a + b }}
+
+func TestDiccionarioAgregadoContrato(t *testing.T) {
+	t.Log("Una secuencia aleatoria de operaciones se comporta igual que un map ordenado")
+	verificarContratoOrdenado(t, TDADiccionario.CrearDiccionarioAgregado[int, int](cmp.Compare, monoideSuma), 19)
+}
+
+func TestDiccionarioAgregadoVentas(t *testing.T) {
+	t.Log("Suma y mínimo de las ventas en un rango de días")
+	sumas := TDADiccionario.CrearDiccionarioAgregado[int, int](cmp.Compare, monoideSuma)
+	minimos := TDADiccionario.CrearDiccionarioAgregado[int, int](cmp.Compare, TDADiccionario.Monoide[int]{
+		Neutro: math.MaxInt, Combinar: func(a, b int) int { return min(a, b) },
+	})
+	ventas := map[int]int{1: 100, 3: 50, 4: 300, 8: 20, 10: 70}
+	for dia, venta := range ventas {
+		sumas.Guardar(dia, venta)
+		minimos.Guardar(dia, venta)
+	}
+
+	desde, hasta := 2, 8
+	require.EqualValues(t, 370, sumas.Agregar(&desde, &hasta))
+	require.EqualValues(t, 20, minimos.Agregar(&desde, &hasta))
+	require.EqualValues(t, 540, sumas.Agregar(nil, nil))
+	require.EqualValues(t, 100, sumas.Agregar(nil, &desde))
+	require.EqualValues(t, 90, sumas.Agregar(&hasta, nil))
+
+	sinVentas1, sinVentas2 := 5, 7
+	require.EqualValues(t, 0, sumas.Agregar(&sinVentas1, &sinVentas2))
+	require.EqualValues(t, math.MaxInt, minimos.Agregar(&sinVentas1, &sinVentas2))
+
+	sumas.Guardar(4, 0)
+	sumas.Borrar(8)
+	minimos.Borrar(8)
+	require.EqualValues(t, 50, sumas.Agregar(&desde, &hasta))
+	require.EqualValues(t, 50, minimos.Agregar(&desde, &hasta))
+}
+
+func TestDiccionarioAgregadoNoConmutativo(t *testing.T) {
+	t.Log("Con una combinación no conmutativa el resultado respeta el orden de las claves")
+	concatenar := TDADiccionario.Monoide[string]{Neutro: "", Combinar: func(a, b string) string { return a + b }}
+	dic := TDADiccionario.CrearDiccionarioAgregado[int, string](cmp.Compare, concatenar)
+	aleatorio := rand.New(rand.NewSource(3))
+	letras := "abcdefghijklmnopqrstuvwxyz"
+	for _, i := range aleatorio.Perm(len(letras)) {
+		dic.Guardar(i, string(letras[i]))
+	}
+	for i := 0; i < 200; i++ {
+		desde, hasta := aleatorio.Intn(30)-2, aleatorio.Intn(30)-2
+		esperado := ""
+		for j := max(desde, 0); j <= hasta && j < len(letras); j++ {
+			esperado += string(letras[j])
+		}
+		require.EqualValues(t, esperado, dic.Agregar(&desde, &hasta))
+	}
+}
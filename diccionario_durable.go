@@ -0,0 +1,408 @@
+package diccionario
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// PoliticaSincronizacion indica cuándo se fuerza a disco (fsync) el log de escritura anticipada
+type PoliticaSincronizacion int
+
+const (
+	// SincronizarSiempre hace fsync luego de cada Guardar o Borrar. Es la única política que garantiza
+	// que toda operación que retornó sobrevive a una caída del sistema operativo
+	SincronizarSiempre PoliticaSincronizacion = iota
+
+	// SincronizarCadaN hace fsync cada OpcionesDurable.Operaciones operaciones
+	SincronizarCadaN
+
+	// SincronizarNunca deja la escritura a disco en manos del sistema operativo (o de Sincronizar y Cerrar)
+	SincronizarNunca
+)
+
+// OpcionesDurable configura el comportamiento de un DiccionarioDurable
+type OpcionesDurable struct {
+	Sincronizacion PoliticaSincronizacion
+
+	// Operaciones es la cantidad de operaciones entre cada fsync cuando se usa SincronizarCadaN
+	Operaciones int
+}
+
+// DiccionarioDurable es un DiccionarioOrdenado cuyas modificaciones se registran en un log de escritura
+// anticipada (WAL) antes de aplicarse en memoria, de forma que puede reconstruirse luego de una caída.
+//
+// Como Guardar y Borrar no devuelven error, si la escritura en el log falla entran en pánico con un error
+// que envuelve a ErrEscrituraLog, y el diccionario en memoria no se modifica. La entrada que no se pudo escribir
+// se quita del log; si ni siquiera eso es posible, el diccionario queda inutilizable y toda modificación
+// posterior entra en pánico con el mismo error, en lugar de agregar entradas que no se podrían recuperar.
+type DiccionarioDurable[K comparable, V any] interface {
+	DiccionarioOrdenado[K, V]
+
+	// Compactar escribe una instantánea completa del diccionario y vacía el log
+	Compactar() error
+
+	// Sincronizar fuerza a disco todas las operaciones registradas hasta el momento
+	Sincronizar() error
+
+	// Cerrar sincroniza y cierra el log. Luego de cerrado, el diccionario no debe volver a usarse
+	Cerrar() error
+}
+
+// ErrEscrituraLog es el error envuelto en el pánico de Guardar o Borrar cuando no se pudo escribir el log
+var ErrEscrituraLog = errors.New("no se pudo escribir el log del diccionario")
+
+const (
+	opGuardar byte = iota + 1
+	opBorrar
+)
+
+const (
+	extensionInstantanea = ".snapshot"
+	extensionTemporal    = ".tmp"
+	tamanioCabecera      = 8
+)
+
+// registroLog es el contenido de cada entrada del log y de la instantánea
+type registroLog[K comparable, V any] struct {
+	Operacion byte
+	Clave     K
+	Dato      V
+}
+
+type diccionarioDurable[K comparable, V any] struct {
+	dic        DiccionarioOrdenado[K, V]
+	ruta       string
+	log        *os.File
+	opciones   OpcionesDurable
+	pendientes int
+
+	// tamanioLog es el largo de la parte válida del log, donde empieza la próxima entrada
+	tamanioLog int64
+
+	// fallo es el error que dejó al log con una entrada incompleta que no pudo quitarse, o nil
+	fallo error
+}
+
+// AbrirDiccionarioDurable abre (o crea) el diccionario almacenado en ruta, reconstruyendo el ABB a partir de la
+// última instantánea y del log. Las claves y los datos se serializan con encoding/gob, por lo que deben ser
+// tipos que gob pueda codificar.
+//
+// Una entrada final incompleta o con checksum inválido se considera una escritura interrumpida por una caída:
+// se descarta y el log se trunca en ese punto. Si, en cambio, después de una entrada inválida hay entradas
+// válidas, el log está dañado y no sólo cortado: se devuelve un error sin modificar el archivo, ya que truncarlo
+// perdería operaciones confirmadas.
+func AbrirDiccionarioDurable[K comparable, V any](ruta string, cmp func(K, K) int, opciones OpcionesDurable) (DiccionarioDurable[K, V], error) {
+	if opciones.Sincronizacion == SincronizarCadaN && opciones.Operaciones <= 0 {
+		return nil, fmt.Errorf("cantidad de operaciones entre sincronizaciones invalida: %d", opciones.Operaciones)
+	}
+	d := &diccionarioDurable[K, V]{
+		dic:      CrearABB[K, V](cmp),
+		ruta:     ruta,
+		opciones: opciones,
+	}
+
+	if err := d.cargarInstantanea(); err != nil {
+		return nil, err
+	}
+
+	log, err := os.OpenFile(ruta, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("abriendo log %s: %w", ruta, err)
+	}
+	validos, _, err := d.reproducir(log)
+	if err != nil {
+		log.Close()
+		return nil, err
+	}
+	if err := log.Truncate(validos); err != nil {
+		log.Close()
+		return nil, fmt.Errorf("truncando log %s: %w", ruta, err)
+	}
+	if _, err := log.Seek(validos, io.SeekStart); err != nil {
+		log.Close()
+		return nil, fmt.Errorf("posicionando log %s: %w", ruta, err)
+	}
+	d.log = log
+	d.tamanioLog = validos
+	return d, nil
+}
+
+// cargarInstantanea carga la instantánea, si existe. A diferencia del log, una instantánea dañada es un error,
+// ya que sólo se reemplaza de forma atómica una vez escrita completa
+func (d *diccionarioDurable[K, V]) cargarInstantanea() error {
+	archivo, err := os.Open(d.ruta + extensionInstantanea)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("abriendo instantanea: %w", err)
+	}
+	defer archivo.Close()
+
+	validos, tamanio, err := d.reproducir(archivo)
+	if err != nil {
+		return err
+	}
+	if validos != tamanio {
+		return fmt.Errorf("instantanea %s dañada en el byte %d", d.ruta+extensionInstantanea, validos)
+	}
+	return nil
+}
+
+// reproducir aplica sobre el diccionario en memoria todas las entradas válidas del archivo, y devuelve la
+// cantidad de bytes que ocupan junto con el tamaño total del archivo
+func (d *diccionarioDurable[K, V]) reproducir(archivo *os.File) (int64, int64, error) {
+	info, err := archivo.Stat()
+	if err != nil {
+		return 0, 0, fmt.Errorf("leyendo %s: %w", archivo.Name(), err)
+	}
+	lector := bufio.NewReader(archivo)
+	var validos int64
+	for {
+		registro, tam, ok := leerRegistro[K, V](lector, info.Size()-validos)
+		if !ok {
+			if err := verificarFinal[K, V](archivo, validos, info.Size()); err != nil {
+				return validos, info.Size(), err
+			}
+			return validos, info.Size(), nil
+		}
+		switch registro.Operacion {
+		case opGuardar:
+			d.dic.Guardar(registro.Clave, registro.Dato)
+		case opBorrar:
+			// Si se cayó luego de renombrar la instantánea pero antes de vaciar el log, la clave
+			// puede ya no estar
+			if d.dic.Pertenece(registro.Clave) {
+				d.dic.Borrar(registro.Clave)
+			}
+		default:
+			return validos, info.Size(), fmt.Errorf("operacion desconocida %d en el byte %d", registro.Operacion, validos)
+		}
+		validos += tam
+	}
+}
+
+// verificarFinal comprueba que la entrada inválida que empieza en el byte desde sea la última del archivo,
+// buscando una entrada válida que empiece en cualquier byte posterior. Como no se puede confiar en el largo de
+// la entrada inválida, se prueba cada posición
+func verificarFinal[K comparable, V any](archivo *os.File, desde, tamanio int64) error {
+	resto := make([]byte, tamanio-desde)
+	if _, err := archivo.ReadAt(resto, desde); err != nil && err != io.EOF {
+		return fmt.Errorf("leyendo %s: %w", archivo.Name(), err)
+	}
+	for i := 1; i < len(resto); i++ {
+		if _, _, ok := leerRegistro[K, V](bytes.NewReader(resto[i:]), int64(len(resto)-i)); ok {
+			return fmt.Errorf("%s dañado en el byte %d: hay entradas validas en el byte %d", archivo.Name(), desde, desde+int64(i))
+		}
+	}
+	return nil
+}
+
+// leerRegistro lee una entrada con el formato [largo uint32][crc32 uint32][registro gob], sabiendo que quedan
+// disponibles bytes por leer. Devuelve false si la entrada está incompleta o su checksum no coincide. Un largo
+// mayor a lo que queda del archivo se trata igual que una entrada incompleta, sin reservar memoria para él, ya
+// que la cabecera puede estar dañada
+func leerRegistro[K comparable, V any](r io.Reader, disponibles int64) (registroLog[K, V], int64, bool) {
+	var registro registroLog[K, V]
+	var cabecera [tamanioCabecera]byte
+	if _, err := io.ReadFull(r, cabecera[:]); err != nil {
+		return registro, 0, false
+	}
+	largo := binary.LittleEndian.Uint32(cabecera[0:4])
+	checksum := binary.LittleEndian.Uint32(cabecera[4:8])
+	if int64(largo) > disponibles-tamanioCabecera {
+		return registro, 0, false
+	}
+
+	contenido := make([]byte, largo)
+	if _, err := io.ReadFull(r, contenido); err != nil {
+		return registro, 0, false
+	}
+	if crc32.ChecksumIEEE(contenido) != checksum {
+		return registro, 0, false
+	}
+	if err := gob.NewDecoder(bytes.NewReader(contenido)).Decode(&registro); err != nil {
+		return registro, 0, false
+	}
+	return registro, tamanioCabecera + int64(largo), true
+}
+
+func codificarRegistro[K comparable, V any](registro registroLog[K, V]) ([]byte, error) {
+	var contenido bytes.Buffer
+	if err := gob.NewEncoder(&contenido).Encode(registro); err != nil {
+		return nil, err
+	}
+	entrada := make([]byte, tamanioCabecera+contenido.Len())
+	binary.LittleEndian.PutUint32(entrada[0:4], uint32(contenido.Len()))
+	binary.LittleEndian.PutUint32(entrada[4:8], crc32.ChecksumIEEE(contenido.Bytes()))
+	copy(entrada[tamanioCabecera:], contenido.Bytes())
+	return entrada, nil
+}
+
+// registrar escribe la operación en el log y aplica la política de sincronización
+func (d *diccionarioDurable[K, V]) registrar(registro registroLog[K, V]) {
+	entrada, err := codificarRegistro(registro)
+	if err != nil {
+		panic(fmt.Errorf("%w: %w", ErrEscrituraLog, err))
+	}
+	if d.fallo != nil {
+		panic(d.fallo)
+	}
+	if _, err := d.log.Write(entrada); err != nil {
+		d.descartarEntrada()
+		panic(fmt.Errorf("%w: %w", ErrEscrituraLog, err))
+	}
+	d.pendientes++
+	if d.opciones.Sincronizacion == SincronizarSiempre ||
+		(d.opciones.Sincronizacion == SincronizarCadaN && d.pendientes >= d.opciones.Operaciones) {
+		if err := d.Sincronizar(); err != nil {
+			// La operación no se aplicará en memoria, así que tampoco debe quedar en el log
+			d.descartarEntrada()
+			panic(fmt.Errorf("%w: %w", ErrEscrituraLog, err))
+		}
+	}
+	d.tamanioLog += int64(len(entrada))
+}
+
+// descartarEntrada trunca el log al final de la última entrada válida, quitando lo que se haya llegado a escribir
+// de una entrada fallida. Si no lo logra, marca al diccionario como fallido
+func (d *diccionarioDurable[K, V]) descartarEntrada() {
+	err := d.log.Truncate(d.tamanioLog)
+	if err == nil {
+		_, err = d.log.Seek(d.tamanioLog, io.SeekStart)
+	}
+	if err != nil {
+		d.fallo = fmt.Errorf("%w: el log quedo con una entrada incompleta: %w", ErrEscrituraLog, err)
+	}
+}
+
+func (d *diccionarioDurable[K, V]) Guardar(clave K, dato V) {
+	d.registrar(registroLog[K, V]{Operacion: opGuardar, Clave: clave, Dato: dato})
+	d.dic.Guardar(clave, dato)
+}
+
+func (d *diccionarioDurable[K, V]) Pertenece(clave K) bool {
+	return d.dic.Pertenece(clave)
+}
+
+func (d *diccionarioDurable[K, V]) Obtener(clave K) V {
+	return d.dic.Obtener(clave)
+}
+
+func (d *diccionarioDurable[K, V]) Borrar(clave K) V {
+	if !d.dic.Pertenece(clave) {
+		panic("La clave no pertenece al diccionario")
+	}
+	d.registrar(registroLog[K, V]{Operacion: opBorrar, Clave: clave})
+	return d.dic.Borrar(clave)
+}
+
+func (d *diccionarioDurable[K, V]) Cantidad() int {
+	return d.dic.Cantidad()
+}
+
+func (d *diccionarioDurable[K, V]) Iterar(visitar func(K, V) bool) {
+	d.dic.Iterar(visitar)
+}
+
+func (d *diccionarioDurable[K, V]) Iterador() IterDiccionario[K, V] {
+	return d.dic.Iterador()
+}
+
+func (d *diccionarioDurable[K, V]) IterarRango(desde *K, hasta *K, visitar func(K, V) bool) {
+	d.dic.IterarRango(desde, hasta, visitar)
+}
+
+func (d *diccionarioDurable[K, V]) IteradorRango(desde *K, hasta *K) IterDiccionario[K, V] {
+	return d.dic.IteradorRango(desde, hasta)
+}
+
+func (d *diccionarioDurable[K, V]) Sincronizar() error {
+	if err := d.log.Sync(); err != nil {
+		return err
+	}
+	d.pendientes = 0
+	return nil
+}
+
+// Compactar escribe la instantánea en un archivo temporal, la sincroniza y la renombra sobre la anterior antes
+// de vaciar el log. Si el sistema se cae en medio, al reabrir se obtiene el mismo contenido: o bien la
+// instantánea vieja con el log completo, o bien la nueva con un log que vuelve a aplicar operaciones ya incluidas
+func (d *diccionarioDurable[K, V]) Compactar() error {
+	rutaInstantanea := d.ruta + extensionInstantanea
+	rutaTemporal := rutaInstantanea + extensionTemporal
+
+	temporal, err := os.Create(rutaTemporal)
+	if err != nil {
+		return fmt.Errorf("creando instantanea: %w", err)
+	}
+	escritor := bufio.NewWriter(temporal)
+	var errEscritura error
+	d.dic.Iterar(func(clave K, dato V) bool {
+		var entrada []byte
+		entrada, errEscritura = codificarRegistro(registroLog[K, V]{Operacion: opGuardar, Clave: clave, Dato: dato})
+		if errEscritura == nil {
+			_, errEscritura = escritor.Write(entrada)
+		}
+		return errEscritura == nil
+	})
+	if errEscritura == nil {
+		errEscritura = escritor.Flush()
+	}
+	if errEscritura == nil {
+		errEscritura = temporal.Sync()
+	}
+	if err := temporal.Close(); errEscritura == nil {
+		errEscritura = err
+	}
+	if errEscritura != nil {
+		os.Remove(rutaTemporal)
+		return fmt.Errorf("escribiendo instantanea: %w", errEscritura)
+	}
+
+	if err := os.Rename(rutaTemporal, rutaInstantanea); err != nil {
+		return fmt.Errorf("reemplazando instantanea: %w", err)
+	}
+	if err := sincronizarDirectorio(filepath.Dir(d.ruta)); err != nil {
+		return err
+	}
+
+	if err := d.log.Truncate(0); err != nil {
+		return fmt.Errorf("vaciando log: %w", err)
+	}
+	if _, err := d.log.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("vaciando log: %w", err)
+	}
+	d.tamanioLog = 0
+	return d.Sincronizar()
+}
+
+func (d *diccionarioDurable[K, V]) Cerrar() error {
+	errSync := d.Sincronizar()
+	errCierre := d.log.Close()
+	if errSync != nil {
+		return errSync
+	}
+	return errCierre
+}
+
+// sincronizarDirectorio hace persistente el renombrado de un archivo dentro del directorio
+func sincronizarDirectorio(ruta string) error {
+	dir, err := os.Open(ruta)
+	if err != nil {
+		return fmt.Errorf("abriendo directorio %s: %w", ruta, err)
+	}
+	defer dir.Close()
+	if err := dir.Sync(); err != nil {
+		return fmt.Errorf("sincronizando directorio %s: %w", ruta, err)
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package diccionario_test
+
+import (
+	"cmp"
+	"os"
+	"path/filepath"
+	"strings"
+	TDADiccionario "tdas/diccionario"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func abrirDurable(t *testing.T, ruta string) TDADiccionario.DiccionarioDurable[string, int] {
+	dic, err := TDADiccionario.AbrirDiccionarioDurable[string, int](ruta, strings.Compare,
+		TDADiccionario.OpcionesDurable{Sincronizacion: TDADiccionario.SincronizarSiempre})
+	require.NoError(t, err)
+	return dic
+}
+
+func TestDiccionarioDurableReabrir(t *testing.T) {
+	t.Log("Las operaciones realizadas sobreviven al cerrar y volver a abrir el diccionario")
+	ruta := filepath.Join(t.TempDir(), "dic.wal")
+	dic := abrirDurable(t, ruta)
+	dic.Guardar("A", 1)
+	dic.Guardar("B", 2)
+	dic.Guardar("C", 3)
+	dic.Guardar("A", 10)
+	require.EqualValues(t, 2, dic.Borrar("B"))
+	require.NoError(t, dic.Cerrar())
+
+	dic = abrirDurable(t, ruta)
+	defer dic.Cerrar()
+	require.EqualValues(t, 2, dic.Cantidad())
+	require.EqualValues(t, 10, dic.Obtener("A"))
+	require.False(t, dic.Pertenece("B"))
+	require.EqualValues(t, 3, dic.Obtener("C"))
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar("B") })
+}
+
+func TestDiccionarioDurableEntradaIncompleta(t *testing.T) {
+	t.Log("Una entrada final cortada por una caída se descarta y el resto del log se conserva")
+	ruta := filepath.Join(t.TempDir(), "dic.wal")
+	dic := abrirDurable(t, ruta)
+	dic.Guardar("A", 1)
+	dic.Guardar("B", 2)
+	require.NoError(t, dic.Cerrar())
+
+	info, err := os.Stat(ruta)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(ruta, info.Size()-3))
+
+	dic = abrirDurable(t, ruta)
+	require.EqualValues(t, 1, dic.Cantidad())
+	require.EqualValues(t, 1, dic.Obtener("A"))
+	dic.Guardar("C", 3)
+	require.NoError(t, dic.Cerrar())
+
+	dic = abrirDurable(t, ruta)
+	defer dic.Cerrar()
+	require.EqualValues(t, 2, dic.Cantidad())
+	require.EqualValues(t, 3, dic.Obtener("C"))
+}
+
+func TestDiccionarioDurableChecksumInvalido(t *testing.T) {
+	t.Log("Una entrada final con checksum inválido se descarta")
+	ruta := filepath.Join(t.TempDir(), "dic.wal")
+	dic := abrirDurable(t, ruta)
+	dic.Guardar("A", 1)
+	dic.Guardar("B", 2)
+	dic.Guardar("C", 3)
+	require.NoError(t, dic.Cerrar())
+
+	contenido, err := os.ReadFile(ruta)
+	require.NoError(t, err)
+	contenido[len(contenido)-2] ^= 0xFF
+	require.NoError(t, os.WriteFile(ruta, contenido, 0o644))
+
+	dic = abrirDurable(t, ruta)
+	defer dic.Cerrar()
+	require.EqualValues(t, 2, dic.Cantidad())
+	require.True(t, dic.Pertenece("B"))
+	require.False(t, dic.Pertenece("C"))
+}
+
+func TestDiccionarioDurableEntradaInvalidaEnElMedio(t *testing.T) {
+	t.Log("Una entrada dañada seguida de entradas válidas es un error y el log no se trunca")
+	ruta := filepath.Join(t.TempDir(), "dic.wal")
+	dic := abrirDurable(t, ruta)
+	dic.Guardar("A", 1)
+	require.NoError(t, dic.Cerrar())
+	info, err := os.Stat(ruta)
+	require.NoError(t, err)
+	primera := info.Size()
+
+	dic = abrirDurable(t, ruta)
+	dic.Guardar("B", 2)
+	dic.Guardar("C", 3)
+	require.NoError(t, dic.Cerrar())
+
+	for _, posicion := range []int64{primera + 10, primera + 1} {
+		contenido, err := os.ReadFile(ruta)
+		require.NoError(t, err)
+		corrupto := append([]byte(nil), contenido...)
+		corrupto[posicion] ^= 0xFF
+		require.NoError(t, os.WriteFile(ruta, corrupto, 0o644))
+
+		_, err = TDADiccionario.AbrirDiccionarioDurable[string, int](ruta, strings.Compare,
+			TDADiccionario.OpcionesDurable{Sincronizacion: TDADiccionario.SincronizarSiempre})
+		require.Error(t, err)
+		despues, err := os.ReadFile(ruta)
+		require.NoError(t, err)
+		require.EqualValues(t, corrupto, despues)
+
+		require.NoError(t, os.WriteFile(ruta, contenido, 0o644))
+	}
+
+	dic = abrirDurable(t, ruta)
+	defer dic.Cerrar()
+	require.EqualValues(t, 3, dic.Cantidad())
+}
+
+func TestDiccionarioDurableCompactar(t *testing.T) {
+	t.Log("Compactar vacía el log y el contenido se recupera desde la instantánea")
+	ruta := filepath.Join(t.TempDir(), "dic.wal")
+	dic, err := TDADiccionario.AbrirDiccionarioDurable[int, int](ruta, cmp.Compare,
+		TDADiccionario.OpcionesDurable{Sincronizacion: TDADiccionario.SincronizarCadaN, Operaciones: 50})
+	require.NoError(t, err)
+	for i := 0; i < 200; i++ {
+		dic.Guardar(i, i*2)
+	}
+	for i := 0; i < 200; i += 2 {
+		dic.Borrar(i)
+	}
+	require.NoError(t, dic.Compactar())
+
+	info, err := os.Stat(ruta)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, info.Size())
+
+	dic.Guardar(1000, 1)
+	require.NoError(t, dic.Cerrar())
+
+	dic, err = TDADiccionario.AbrirDiccionarioDurable[int, int](ruta, cmp.Compare,
+		TDADiccionario.OpcionesDurable{Sincronizacion: TDADiccionario.SincronizarNunca})
+	require.NoError(t, err)
+	defer dic.Cerrar()
+	require.EqualValues(t, 101, dic.Cantidad())
+	desde, hasta := 10, 20
+	claves := []int{}
+	dic.IterarRango(&desde, &hasta, func(clave int, dato int) bool {
+		require.EqualValues(t, clave*2, dato)
+		claves = append(claves, clave)
+		return true
+	})
+	require.EqualValues(t, []int{11, 13, 15, 17, 19}, claves)
+	require.EqualValues(t, 1, dic.Obtener(1000))
+}
+
+func TestDiccionarioDurableOpcionesInvalidas(t *testing.T) {
+	t.Log("SincronizarCadaN requiere una cantidad positiva de operaciones")
+	ruta := filepath.Join(t.TempDir(), "dic.wal")
+	_, err := TDADiccionario.AbrirDiccionarioDurable[int, int](ruta, cmp.Compare,
+		TDADiccionario.OpcionesDurable{Sincronizacion: TDADiccionario.SincronizarCadaN})
+	require.Error(t, err)
+}
+
+func TestDiccionarioDurableLargoInvalido(t *testing.T) {
+	t.Log("Una cabecera con un largo mayor al resto del archivo se descarta sin reservar memoria para él")
+	ruta := filepath.Join(t.TempDir(), "dic.wal")
+	dic := abrirDurable(t, ruta)
+	dic.Guardar("A", 1)
+	require.NoError(t, dic.Cerrar())
+
+	archivo, err := os.OpenFile(ruta, os.O_WRONLY|os.O_APPEND, 0o644)
+	require.NoError(t, err)
+	_, err = archivo.Write([]byte{0xFF, 0xFF, 0xFF, 0xFF, 0, 0, 0, 0, 1, 2, 3})
+	require.NoError(t, err)
+	require.NoError(t, archivo.Close())
+
+	dic = abrirDurable(t, ruta)
+	defer dic.Cerrar()
+	require.EqualValues(t, 1, dic.Cantidad())
+	dic.Guardar("B", 2)
+	require.EqualValues(t, 2, dic.Obtener("B"))
+}
@@ -0,0 +1,103 @@
+package diccionario_test
+
+import (
+	"cmp"
+	"math/rand"
+	TDADiccionario "tdas/diccionario"
+	"testing"
+)
+
+const cantidadBenchmark = 100000
+
+// implementacionesBenchmark son las implementaciones de DiccionarioOrdenado que se comparan entre sí
+var implementacionesBenchmark = []struct {
+	nombre string
+	crear  func() TDADiccionario.DiccionarioOrdenado[int, int]
+}{
+	{"ABB", func() TDADiccionario.DiccionarioOrdenado[int, int] {
+		return TDADiccionario.CrearABB[int, int](cmp.Compare)
+	}},
+	{"ArbolB-2", func() TDADiccionario.DiccionarioOrdenado[int, int] {
+		return TDADiccionario.CrearArbolB[int, int](cmp.Compare, 2)
+	}},
+	{"ArbolB-32", func() TDADiccionario.DiccionarioOrdenado[int, int] {
+		return TDADiccionario.CrearArbolB[int, int](cmp.Compare, 32)
+	}},
+	{"SkipList", func() TDADiccionario.DiccionarioOrdenado[int, int] {
+		return TDADiccionario.CrearSkipListConSemilla[int, int](cmp.Compare, 1)
+	}},
+	{"Treap", func() TDADiccionario.DiccionarioOrdenado[int, int] {
+		return TDADiccionario.CrearTreapConSemilla[int, int](cmp.Compare, 1)
+	}},
+	{"Splay", func() TDADiccionario.DiccionarioOrdenado[int, int] {
+		return TDADiccionario.CrearSplay[int, int](cmp.Compare)
+	}},
+}
+
+func clavesBenchmark() []int {
+	return rand.New(rand.NewSource(42)).Perm(cantidadBenchmark)
+}
+
+func cargarBenchmark(crear func() TDADiccionario.DiccionarioOrdenado[int, int], claves []int) TDADiccionario.DiccionarioOrdenado[int, int] {
+	dic := crear()
+	for _, clave := range claves {
+		dic.Guardar(clave, clave)
+	}
+	return dic
+}
+
+func BenchmarkGuardar(b *testing.B) {
+	claves := clavesBenchmark()
+	for _, impl := range implementacionesBenchmark {
+		b.Run(impl.nombre, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				cargarBenchmark(impl.crear, claves)
+			}
+		})
+	}
+}
+
+func BenchmarkObtener(b *testing.B) {
+	claves := clavesBenchmark()
+	for _, impl := range implementacionesBenchmark {
+		b.Run(impl.nombre, func(b *testing.B) {
+			dic := cargarBenchmark(impl.crear, claves)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				dic.Obtener(claves[i%len(claves)])
+			}
+		})
+	}
+}
+
+func BenchmarkBorrar(b *testing.B) {
+	claves := clavesBenchmark()
+	for _, impl := range implementacionesBenchmark {
+		b.Run(impl.nombre, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				dic := cargarBenchmark(impl.crear, claves)
+				b.StartTimer()
+				for _, clave := range claves {
+					dic.Borrar(clave)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkIteradorRango(b *testing.B) {
+	claves := clavesBenchmark()
+	desde, hasta := cantidadBenchmark/4, 3*cantidadBenchmark/4
+	for _, impl := range implementacionesBenchmark {
+		b.Run(impl.nombre, func(b *testing.B) {
+			dic := cargarBenchmark(impl.crear, claves)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				for iter := dic.IteradorRango(&desde, &hasta); iter.HaySiguiente(); iter.Siguiente() {
+					iter.VerActual()
+				}
+			}
+		})
+	}
+}
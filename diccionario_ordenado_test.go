@@ -2,6 +2,7 @@ package diccionario_test
 
 import (
 	"cmp"
+	"strconv"
 	"strings"
 	TDADiccionario "tdas/diccionario"
 	"testing"
@@ -563,3 +564,55 @@ func TestDiccionarioOrdenadoVolumenIteradorCorte(t *testing.T) {
 	require.False(t, siguioEjecutandoCuandoNoDebia,
 		"No debería haber seguido ejecutando si encontramos un elemento que hizo que la iteración corte")
 }
+
+func TestDiccionarioOrdenadoSacarExtremos(t *testing.T) {
+	t.Log("SacarMinimo y SacarMaximo sacan las claves de ambos extremos hasta vaciar el diccionario")
+	dic := TDADiccionario.CrearABB[int, string](cmp.Compare)
+	claves := []int{50, 30, 70, 20, 40, 60, 80, 35, 65}
+	for _, clave := range claves {
+		dic.Guardar(clave, strconv.Itoa(clave))
+	}
+
+	clave, dato := dic.SacarMinimo()
+	require.EqualValues(t, 20, clave)
+	require.EqualValues(t, "20", dato)
+	clave, _ = dic.SacarMaximo()
+	require.EqualValues(t, 80, clave)
+	clave, _ = dic.SacarMinimo()
+	require.EqualValues(t, 30, clave)
+	clave, _ = dic.SacarMaximo()
+	require.EqualValues(t, 70, clave)
+	require.EqualValues(t, 5, dic.Cantidad())
+	require.False(t, dic.Pertenece(30))
+	require.True(t, dic.Pertenece(35))
+
+	restantes := []int{}
+	for {
+		clave, _, ok := dic.IntentarSacarMinimo()
+		if !ok {
+			break
+		}
+		restantes = append(restantes, clave)
+	}
+	require.EqualValues(t, []int{35, 40, 50, 60, 65}, restantes)
+	require.EqualValues(t, 0, dic.Cantidad())
+	require.False(t, dic.Iterador().HaySiguiente())
+}
+
+func TestDiccionarioOrdenadoSacarExtremosVacio(t *testing.T) {
+	t.Log("Sacar extremos de un diccionario vacío entra en pánico, salvo con las variantes Intentar")
+	dic := TDADiccionario.CrearABB[string, int](strings.Compare)
+	require.PanicsWithValue(t, "El diccionario esta vacio", func() { dic.SacarMinimo() })
+	require.PanicsWithValue(t, "El diccionario esta vacio", func() { dic.SacarMaximo() })
+	_, _, ok := dic.IntentarSacarMinimo()
+	require.False(t, ok)
+	_, _, ok = dic.IntentarSacarMaximo()
+	require.False(t, ok)
+
+	dic.Guardar("A", 1)
+	clave, dato, ok := dic.IntentarSacarMaximo()
+	require.True(t, ok)
+	require.EqualValues(t, "A", clave)
+	require.EqualValues(t, 1, dato)
+	require.EqualValues(t, 0, dic.Cantidad())
+}
@@ -0,0 +1,278 @@
+package diccionario
+
+import (
+	"sync"
+	"time"
+)
+
+// DiccionarioTTL es un DiccionarioOrdenado cuyas claves pueden vencer. Una clave vencida no es visible para
+// ninguna primitiva (Pertenece, Obtener, Borrar, Cantidad ni los iteradores): cada primitiva borra primero las
+// claves vencidas, en orden de vencimiento. Todas las primitivas, incluidas las de los iteradores, pueden usarse
+// desde varias goroutines a la vez
+type DiccionarioTTL[K comparable, V any] interface {
+	DiccionarioOrdenado[K, V]
+
+	// GuardarConTTL guarda el par clave-dato, que vence cuando pase la duración indicada. Si la clave ya se
+	// encontraba, se reemplazan su dato y su vencimiento. Si la duración no es positiva, entra en pánico con un
+	// mensaje 'La duracion debe ser positiva'. Guardar, en cambio, guarda la clave sin vencimiento
+	GuardarConTTL(clave K, dato V, duracion time.Duration)
+
+	// Purgar borra las claves vencidas y devuelve cuántas borró
+	Purgar() int
+
+	// Detener frena la limpieza en segundo plano, si se configuró una. Puede llamarse más de una vez
+	Detener()
+}
+
+// OpcionesTTL configura un DiccionarioTTL
+type OpcionesTTL struct {
+	// Reloj devuelve el instante actual. Si es nil se usa time.Now; en las pruebas permite controlar el paso
+	// del tiempo
+	Reloj func() time.Time
+
+	// IntervaloLimpieza, si es positivo, inicia una goroutine que llama a Purgar con esa frecuencia (medida en
+	// tiempo real, no con Reloj) hasta que se llame a Detener, para liberar la memoria de las claves vencidas
+	// aunque no se use el diccionario
+	IntervaloLimpieza time.Duration
+
+	// AlPurgar, si no es nil, se llama desde la goroutine de limpieza después de cada purga periódica con la
+	// cantidad de claves borradas, sin tener tomado el mutex
+	AlPurgar func(borradas int)
+}
+
+// entradaTTL es el dato de una clave junto con su vencimiento, si tiene
+type entradaTTL[V any] struct {
+	dato     V
+	vence    time.Time
+	vencible bool
+}
+
+// vencimientoTTL es la clave del índice de vencimientos, ordenado por instante y luego por clave
+type vencimientoTTL[K comparable] struct {
+	vence time.Time
+	clave K
+}
+
+type diccionarioTTL[K comparable, V any] struct {
+	mutex        sync.Mutex
+	entradas     *avl[K, entradaTTL[V], struct{}]
+	vencimientos *avl[vencimientoTTL[K], struct{}, struct{}]
+	reloj        func() time.Time
+	detener      chan struct{}
+	detenido     sync.Once
+}
+
+// CrearDiccionarioTTL crea un DiccionarioTTL vacío. Además del AVL con las entradas, mantiene otro AVL con las
+// claves ordenadas por vencimiento, por lo que guardar cuesta O(log n) aunque los vencimientos sean crecientes,
+// y borrar las vencidas cuesta O(log n) por clave
+func CrearDiccionarioTTL[K comparable, V any](cmp func(K, K) int, opciones OpcionesTTL) DiccionarioTTL[K, V] {
+	d := &diccionarioTTL[K, V]{
+		entradas: &avl[K, entradaTTL[V], struct{}]{cmp: cmp},
+		vencimientos: &avl[vencimientoTTL[K], struct{}, struct{}]{cmp: func(a, b vencimientoTTL[K]) int {
+			if resultado := a.vence.Compare(b.vence); resultado != 0 {
+				return resultado
+			}
+			return cmp(a.clave, b.clave)
+		}},
+		reloj:   opciones.Reloj,
+		detener: make(chan struct{}),
+	}
+	if d.reloj == nil {
+		d.reloj = time.Now
+	}
+	if opciones.IntervaloLimpieza > 0 {
+		go d.limpiar(opciones.IntervaloLimpieza, opciones.AlPurgar)
+	}
+	return d
+}
+
+func (d *diccionarioTTL[K, V]) limpiar(intervalo time.Duration, alPurgar func(int)) {
+	ticker := time.NewTicker(intervalo)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			borradas := d.Purgar()
+			if alPurgar != nil {
+				alPurgar(borradas)
+			}
+		case <-d.detener:
+			return
+		}
+	}
+}
+
+func (d *diccionarioTTL[K, V]) Detener() {
+	d.detenido.Do(func() { close(d.detener) })
+}
+
+// purgar borra las claves vencidas. Requiere tener el mutex
+func (d *diccionarioTTL[K, V]) purgar() int {
+	ahora := d.reloj()
+	borradas := 0
+	for minimo := d.vencimientos.minimo(); minimo != nil; minimo = d.vencimientos.minimo() {
+		proximo := minimo.clave
+		if ahora.Before(proximo.vence) {
+			break
+		}
+		d.vencimientos.Borrar(proximo)
+		d.entradas.Borrar(proximo.clave)
+		borradas++
+	}
+	return borradas
+}
+
+func (d *diccionarioTTL[K, V]) Purgar() int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return d.purgar()
+}
+
+// quitarVencimiento borra del índice el vencimiento de la clave, si tenía. Requiere tener el mutex
+func (d *diccionarioTTL[K, V]) quitarVencimiento(clave K) {
+	if nodo := d.entradas.buscarNodo(clave); nodo != nil && nodo.dato.vencible {
+		d.vencimientos.Borrar(vencimientoTTL[K]{vence: nodo.dato.vence, clave: clave})
+	}
+}
+
+func (d *diccionarioTTL[K, V]) Guardar(clave K, dato V) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.purgar()
+	d.quitarVencimiento(clave)
+	d.entradas.Guardar(clave, entradaTTL[V]{dato: dato})
+}
+
+func (d *diccionarioTTL[K, V]) GuardarConTTL(clave K, dato V, duracion time.Duration) {
+	if duracion <= 0 {
+		panic("La duracion debe ser positiva")
+	}
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.purgar()
+	d.quitarVencimiento(clave)
+	vence := d.reloj().Add(duracion)
+	d.entradas.Guardar(clave, entradaTTL[V]{dato: dato, vence: vence, vencible: true})
+	d.vencimientos.Guardar(vencimientoTTL[K]{vence: vence, clave: clave}, struct{}{})
+}
+
+func (d *diccionarioTTL[K, V]) Pertenece(clave K) bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.purgar()
+	return d.entradas.Pertenece(clave)
+}
+
+func (d *diccionarioTTL[K, V]) Obtener(clave K) V {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.purgar()
+	return d.entradas.Obtener(clave).dato
+}
+
+func (d *diccionarioTTL[K, V]) Borrar(clave K) V {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.purgar()
+	d.quitarVencimiento(clave)
+	return d.entradas.Borrar(clave).dato
+}
+
+func (d *diccionarioTTL[K, V]) Cantidad() int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.purgar()
+	return d.entradas.Cantidad()
+}
+
+func (d *diccionarioTTL[K, V]) Iterar(visitar func(K, V) bool) {
+	d.IterarRango(nil, nil, visitar)
+}
+
+// IterarRango usa el iterador externo en lugar de recorrer el AVL con el mutex tomado, para que visitar pueda
+// usar el diccionario
+func (d *diccionarioTTL[K, V]) IterarRango(desde *K, hasta *K, visitar func(K, V) bool) {
+	for iter := d.IteradorRango(desde, hasta); iter.HaySiguiente(); iter.Siguiente() {
+		if !visitar(iter.VerActual()) {
+			return
+		}
+	}
+}
+
+// iteradorTTL sólo recuerda la clave actual y, en cada paso, vuelve a buscarla en el AVL. Si mientras tanto
+// venció o se borró, avanza a la siguiente clave vigente, por lo que nunca devuelve claves vencidas
+type iteradorTTL[K comparable, V any] struct {
+	dic       *diccionarioTTL[K, V]
+	clave     K
+	hayActual bool
+	hasta     *K
+}
+
+func (d *diccionarioTTL[K, V]) Iterador() IterDiccionario[K, V] {
+	return d.IteradorRango(nil, nil)
+}
+
+func (d *diccionarioTTL[K, V]) IteradorRango(desde *K, hasta *K) IterDiccionario[K, V] {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.purgar()
+	iter := &iteradorTTL[K, V]{dic: d, hasta: hasta}
+	primero := d.entradas.minimo()
+	if desde != nil {
+		primero = d.entradas.buscarTecho(*desde)
+	}
+	iter.posicionar(primero)
+	return iter
+}
+
+// posicionar deja al iterador en el nodo, o lo termina si es nil o está fuera del rango. Requiere tener el mutex
+func (iter *iteradorTTL[K, V]) posicionar(nodo *nodoAVL[K, entradaTTL[V], struct{}]) {
+	iter.hayActual = nodo != nil && (iter.hasta == nil || iter.dic.entradas.cmp(nodo.clave, *iter.hasta) <= 0)
+	if iter.hayActual {
+		iter.clave = nodo.clave
+	}
+}
+
+// actual purga las vencidas y devuelve el nodo de la clave actual, avanzando a la siguiente si ya no está.
+// Requiere tener el mutex
+func (iter *iteradorTTL[K, V]) actual() *nodoAVL[K, entradaTTL[V], struct{}] {
+	iter.dic.purgar()
+	if !iter.hayActual {
+		return nil
+	}
+	entradas := iter.dic.entradas
+	if nodo := entradas.buscarNodo(iter.clave); nodo != nil {
+		return nodo
+	}
+	iter.posicionar(entradas.buscarTecho(iter.clave))
+	if !iter.hayActual {
+		return nil
+	}
+	return entradas.buscarNodo(iter.clave)
+}
+
+func (iter *iteradorTTL[K, V]) HaySiguiente() bool {
+	iter.dic.mutex.Lock()
+	defer iter.dic.mutex.Unlock()
+	return iter.actual() != nil
+}
+
+func (iter *iteradorTTL[K, V]) VerActual() (K, V) {
+	iter.dic.mutex.Lock()
+	defer iter.dic.mutex.Unlock()
+	nodo := iter.actual()
+	if nodo == nil {
+		panic("El iterador termino de iterar")
+	}
+	return nodo.clave, nodo.dato.dato
+}
+
+func (iter *iteradorTTL[K, V]) Siguiente() {
+	iter.dic.mutex.Lock()
+	defer iter.dic.mutex.Unlock()
+	nodo := iter.actual()
+	if nodo == nil {
+		panic("El iterador termino de iterar")
+	}
+	iter.posicionar(iter.dic.entradas.buscarSucesor(nodo.clave))
+}
@@ -0,0 +1,170 @@
+package diccionario_test
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	TDADiccionario "tdas/diccionario"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// relojFalso es un reloj que sólo avanza cuando la prueba lo indica
+type relojFalso struct {
+	mutex sync.Mutex
+	ahora time.Time
+}
+
+func (r *relojFalso) Ahora() time.Time {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.ahora
+}
+
+func (r *relojFalso) Avanzar(duracion time.Duration) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.ahora = r.ahora.Add(duracion)
+}
+
+func crearTTL() (TDADiccionario.DiccionarioTTL[string, int], *relojFalso) {
+	reloj := &relojFalso{ahora: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	dic := TDADiccionario.CrearDiccionarioTTL[string, int](strings.Compare, TDADiccionario.OpcionesTTL{Reloj: reloj.Ahora})
+	return dic, reloj
+}
+
+func TestTTLVencimiento(t *testing.T) {
+	t.Log("Las claves vencidas dejan de ser visibles para todas las primitivas")
+	dic, reloj := crearTTL()
+	dic.GuardarConTTL("sesion1", 1, time.Minute)
+	dic.GuardarConTTL("sesion2", 2, time.Hour)
+	dic.Guardar("permanente", 3)
+	require.EqualValues(t, 3, dic.Cantidad())
+
+	reloj.Avanzar(time.Minute)
+	require.False(t, dic.Pertenece("sesion1"))
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Obtener("sesion1") })
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar("sesion1") })
+	require.EqualValues(t, 2, dic.Cantidad())
+
+	reloj.Avanzar(time.Hour)
+	require.EqualValues(t, 1, dic.Cantidad())
+	require.EqualValues(t, 3, dic.Obtener("permanente"))
+	require.PanicsWithValue(t, "La duracion debe ser positiva", func() { dic.GuardarConTTL("x", 0, 0) })
+}
+
+func TestTTLReemplazarVencimiento(t *testing.T) {
+	t.Log("Volver a guardar una clave reemplaza su vencimiento, y Guardar lo quita")
+	dic, reloj := crearTTL()
+	dic.GuardarConTTL("A", 1, time.Minute)
+	dic.GuardarConTTL("A", 2, time.Hour)
+	dic.GuardarConTTL("B", 3, time.Minute)
+	dic.Guardar("B", 4)
+	reloj.Avanzar(2 * time.Minute)
+	require.EqualValues(t, 2, dic.Obtener("A"))
+	require.EqualValues(t, 4, dic.Obtener("B"))
+
+	require.EqualValues(t, 2, dic.Borrar("A"))
+	dic.GuardarConTTL("A", 5, time.Minute)
+	reloj.Avanzar(2 * time.Hour)
+	require.EqualValues(t, 1, dic.Purgar())
+	require.EqualValues(t, 1, dic.Cantidad())
+	require.True(t, dic.Pertenece("B"))
+}
+
+func TestTTLPurgar(t *testing.T) {
+	t.Log("Purgar borra en orden de vencimiento sólo las claves ya vencidas")
+	dic, reloj := crearTTL()
+	for i, clave := range []string{"C", "A", "D", "B"} {
+		dic.GuardarConTTL(clave, i, time.Duration(i+1)*time.Second)
+	}
+	reloj.Avanzar(2 * time.Second)
+	require.EqualValues(t, 2, dic.Purgar())
+	require.EqualValues(t, 0, dic.Purgar())
+	require.False(t, dic.Pertenece("A"))
+	require.True(t, dic.Pertenece("D"))
+}
+
+func TestTTLIteradores(t *testing.T) {
+	t.Log("Los iteradores saltean las claves que vencen mientras se recorre")
+	dic, reloj := crearTTL()
+	dic.GuardarConTTL("A", 1, time.Hour)
+	dic.GuardarConTTL("B", 2, time.Second)
+	dic.GuardarConTTL("C", 3, time.Second)
+	dic.GuardarConTTL("D", 4, time.Hour)
+
+	iter := dic.Iterador()
+	clave, _ := iter.VerActual()
+	require.EqualValues(t, "A", clave)
+	reloj.Avanzar(time.Second)
+	iter.Siguiente()
+	clave, dato := iter.VerActual()
+	require.EqualValues(t, "D", clave)
+	require.EqualValues(t, 4, dato)
+	iter.Siguiente()
+	require.False(t, iter.HaySiguiente())
+	require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.VerActual() })
+
+	desde, hasta := "B", "Z"
+	claves := []string{}
+	dic.IterarRango(&desde, &hasta, func(clave string, _ int) bool {
+		claves = append(claves, clave)
+		if clave == "D" {
+			dic.GuardarConTTL("E", 5, time.Hour)
+		}
+		return dic.Pertenece(clave)
+	})
+	require.EqualValues(t, []string{"D", "E"}, claves)
+}
+
+func TestTTLLimpiezaEnSegundoPlano(t *testing.T) {
+	t.Log("La limpieza en segundo plano borra las claves vencidas sin usar el diccionario")
+	reloj := &relojFalso{ahora: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	purgas := make(chan int, 16)
+	dic := TDADiccionario.CrearDiccionarioTTL[string, int](strings.Compare, TDADiccionario.OpcionesTTL{
+		Reloj:             reloj.Ahora,
+		IntervaloLimpieza: time.Millisecond,
+		AlPurgar: func(borradas int) {
+			if borradas > 0 {
+				purgas <- borradas
+			}
+		},
+	})
+	defer dic.Detener()
+	for _, clave := range []string{"A", "B", "C"} {
+		dic.GuardarConTTL(clave, 0, time.Minute)
+	}
+	reloj.Avanzar(time.Minute)
+	borradas := 0
+	for borradas < 3 {
+		select {
+		case n := <-purgas:
+			borradas += n
+		case <-time.After(10 * time.Second):
+			t.Fatal("La limpieza en segundo plano no borró las claves vencidas")
+		}
+	}
+	require.EqualValues(t, 3, borradas)
+	require.EqualValues(t, 0, dic.Purgar())
+	require.EqualValues(t, 0, dic.Cantidad())
+	dic.Detener()
+	dic.Detener()
+}
+
+func TestTTLMismoTTLVolumen(t *testing.T) {
+	t.Log("Muchas claves con el mismo TTL, cuyos vencimientos son siempre crecientes, se guardan y vencen en orden")
+	dic, reloj := crearTTL()
+	const cantidad = 50000
+	for i := 0; i < cantidad; i++ {
+		dic.GuardarConTTL(strconv.Itoa(i), i, time.Minute)
+		reloj.Avanzar(time.Millisecond)
+	}
+	require.EqualValues(t, cantidad, dic.Cantidad())
+
+	reloj.Avanzar(time.Minute - (cantidad/2+1)*time.Millisecond)
+	require.EqualValues(t, cantidad/2, dic.Purgar())
+	require.False(t, dic.Pertenece(strconv.Itoa(cantidad/2-1)))
+	require.True(t, dic.Pertenece(strconv.Itoa(cantidad/2)))
+}
@@ -0,0 +1,95 @@
+package diccionario
+
+// Estadisticas describe la forma de un árbol binario de búsqueda. Las profundidades se cuentan en aristas desde
+// la raíz, que tiene profundidad 0
+type Estadisticas struct {
+	// Altura es la cantidad de niveles del árbol: 0 si está vacío y 1 si sólo tiene la raíz
+	Altura int
+
+	// ProfundidadMinimaHoja es la profundidad de la hoja menos profunda, o 0 si el árbol está vacío
+	ProfundidadMinimaHoja int
+
+	// ProfundidadPromedio es el promedio de las profundidades de todos los nodos. Una búsqueda exitosa hace en
+	// promedio ProfundidadPromedio+1 comparaciones
+	ProfundidadPromedio float64
+
+	// NodosPorNivel tiene en la posición i la cantidad de nodos con profundidad i
+	NodosPorNivel []int
+
+	// Hojas, NodosUnHijo y NodosDosHijos clasifican a los nodos según su cantidad de hijos
+	Hojas         int
+	NodosUnHijo   int
+	NodosDosHijos int
+
+	// ComparacionesDesperdiciadas estima cuántas comparaciones de más hace en promedio una búsqueda exitosa
+	// respecto de un árbol perfectamente balanceado con la misma cantidad de nodos
+	ComparacionesDesperdiciadas float64
+}
+
+// calcularEstadisticas recorre una única vez el árbol acumulando todas las medidas
+func calcularEstadisticas[K comparable, V any](raiz *nodoABB[K, V]) Estadisticas {
+	var estadisticas Estadisticas
+	if raiz == nil {
+		return estadisticas
+	}
+	cantidad, sumaProfundidades := 0, 0
+	estadisticas.ProfundidadMinimaHoja = -1
+	var recorrer func(n *nodoABB[K, V], profundidad int)
+	recorrer = func(n *nodoABB[K, V], profundidad int) {
+		if profundidad == len(estadisticas.NodosPorNivel) {
+			estadisticas.NodosPorNivel = append(estadisticas.NodosPorNivel, 0)
+		}
+		estadisticas.NodosPorNivel[profundidad]++
+		cantidad++
+		sumaProfundidades += profundidad
+
+		switch {
+		case n.izq == nil && n.der == nil:
+			estadisticas.Hojas++
+			if estadisticas.ProfundidadMinimaHoja == -1 || profundidad < estadisticas.ProfundidadMinimaHoja {
+				estadisticas.ProfundidadMinimaHoja = profundidad
+			}
+		case n.izq != nil && n.der != nil:
+			estadisticas.NodosDosHijos++
+		default:
+			estadisticas.NodosUnHijo++
+		}
+		if n.izq != nil {
+			recorrer(n.izq, profundidad+1)
+		}
+		if n.der != nil {
+			recorrer(n.der, profundidad+1)
+		}
+	}
+	recorrer(raiz, 0)
+
+	estadisticas.Altura = len(estadisticas.NodosPorNivel)
+	estadisticas.ProfundidadPromedio = float64(sumaProfundidades) / float64(cantidad)
+	estadisticas.ComparacionesDesperdiciadas = float64(sumaProfundidades-sumaProfundidadesOptima(cantidad)) / float64(cantidad)
+	return estadisticas
+}
+
+// sumaProfundidadesOptima devuelve la suma de las profundidades de un árbol perfectamente balanceado de
+// cantidad nodos, que llena cada nivel antes de pasar al siguiente
+func sumaProfundidadesOptima(cantidad int) int {
+	suma := 0
+	for profundidad, enNivel := 0, 1; cantidad > 0; profundidad, enNivel = profundidad+1, enNivel*2 {
+		nodos := min(enNivel, cantidad)
+		suma += nodos * profundidad
+		cantidad -= nodos
+	}
+	return suma
+}
+
+func (a *abb[K, V]) Estadisticas() Estadisticas {
+	return calcularEstadisticas(a.raiz)
+}
+
+func (s *scapegoat[K, V]) Estadisticas() Estadisticas {
+	return s.arbol.Estadisticas()
+}
+
+// Estadisticas no splayea, por lo que no modifica la forma del árbol que mide
+func (s *splay[K, V]) Estadisticas() Estadisticas {
+	return calcularEstadisticas(s.raiz)
+}
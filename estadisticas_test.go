@@ -0,0 +1,62 @@
+package diccionario_test
+
+import (
+	"cmp"
+	TDADiccionario "tdas/diccionario"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstadisticasVacio(t *testing.T) {
+	t.Log("Un árbol vacío tiene todas las medidas en cero")
+	dic := TDADiccionario.CrearABB[int, int](cmp.Compare)
+	require.EqualValues(t, TDADiccionario.Estadisticas{}, dic.Estadisticas())
+}
+
+func TestEstadisticasBalanceado(t *testing.T) {
+	t.Log("Un árbol perfectamente balanceado no desperdicia comparaciones")
+	dic := TDADiccionario.CrearABB[int, int](cmp.Compare)
+	for _, clave := range []int{4, 2, 6, 1, 3, 5, 7} {
+		dic.Guardar(clave, clave)
+	}
+	estadisticas := dic.Estadisticas()
+	require.EqualValues(t, 3, estadisticas.Altura)
+	require.EqualValues(t, 2, estadisticas.ProfundidadMinimaHoja)
+	require.InDelta(t, 10.0/7, estadisticas.ProfundidadPromedio, 1e-9)
+	require.EqualValues(t, []int{1, 2, 4}, estadisticas.NodosPorNivel)
+	require.EqualValues(t, 4, estadisticas.Hojas)
+	require.EqualValues(t, 0, estadisticas.NodosUnHijo)
+	require.EqualValues(t, 3, estadisticas.NodosDosHijos)
+	require.InDelta(t, 0, estadisticas.ComparacionesDesperdiciadas, 1e-9)
+}
+
+func TestEstadisticasDegenerado(t *testing.T) {
+	t.Log("Insertar en orden produce una lista, y las comparaciones de más crecen con la cantidad")
+	dic := TDADiccionario.CrearABB[int, int](cmp.Compare)
+	for i := 0; i < 7; i++ {
+		dic.Guardar(i, i)
+	}
+	estadisticas := dic.Estadisticas()
+	require.EqualValues(t, 7, estadisticas.Altura)
+	require.EqualValues(t, 6, estadisticas.ProfundidadMinimaHoja)
+	require.InDelta(t, 3, estadisticas.ProfundidadPromedio, 1e-9)
+	require.EqualValues(t, []int{1, 1, 1, 1, 1, 1, 1}, estadisticas.NodosPorNivel)
+	require.EqualValues(t, 1, estadisticas.Hojas)
+	require.EqualValues(t, 6, estadisticas.NodosUnHijo)
+	require.InDelta(t, 3-10.0/7, estadisticas.ComparacionesDesperdiciadas, 1e-9)
+}
+
+func TestEstadisticasSplay(t *testing.T) {
+	t.Log("Medir un splay tree no cambia su forma")
+	dic := TDADiccionario.CrearSplay[int, int](cmp.Compare)
+	for i := 0; i < 50; i++ {
+		dic.Guardar(i, i)
+	}
+	medible := dic.(interface {
+		Estadisticas() TDADiccionario.Estadisticas
+	})
+	primera := medible.Estadisticas()
+	require.EqualValues(t, 50, primera.Hojas+primera.NodosUnHijo+primera.NodosDosHijos)
+	require.EqualValues(t, primera, medible.Estadisticas())
+}
@@ -0,0 +1,177 @@
+package diccionario
+
+import "errors"
+
+// DiccionarioConHistorial es un DiccionarioOrdenado que registra sus modificaciones para poder deshacerlas y
+// rehacerlas. Como en un editor, modificar el diccionario después de deshacer descarta las operaciones que se
+// podían rehacer
+type DiccionarioConHistorial[K comparable, V any] interface {
+	DiccionarioOrdenado[K, V]
+
+	// PuedeDeshacer indica si hay alguna operación registrada para deshacer
+	PuedeDeshacer() bool
+
+	// PuedeRehacer indica si hay alguna operación deshecha para rehacer
+	PuedeRehacer() bool
+
+	// Deshacer revierte la última operación no deshecha. Si no hay, entra en pánico con un mensaje
+	// 'No hay operaciones para deshacer'
+	Deshacer()
+
+	// Rehacer vuelve a aplicar la última operación deshecha. Si no hay, entra en pánico con un mensaje
+	// 'No hay operaciones para rehacer'
+	Rehacer()
+
+	// Marcar devuelve una marca del estado actual, a la que se puede volver con VolverA
+	Marcar() Marca
+
+	// VolverA deshace o rehace las operaciones necesarias para volver al estado de la marca. Devuelve
+	// ErrMarcaInvalida, sin modificar nada, si la marca pertenece a operaciones que se descartaron, ya sea por
+	// el límite del historial o por modificar después de deshacer
+	VolverA(marca Marca) error
+}
+
+// Marca identifica un punto del historial de un DiccionarioConHistorial
+type Marca struct {
+	// posicion es la cantidad de operaciones aplicadas desde la creación, contando las descartadas
+	posicion int
+
+	// ultima es el identificador de la última operación aplicada en la marca, o 0 si no había ninguna
+	ultima uint64
+}
+
+// ErrMarcaInvalida indica que ya no se puede volver a una marca
+var ErrMarcaInvalida = errors.New("la marca ya no pertenece al historial")
+
+// operacionHistorial guarda el estado de una clave antes y después de una operación, de forma que deshacer y
+// rehacer sean aplicar uno u otro
+type operacionHistorial[K comparable, V any] struct {
+	id            uint64
+	clave         K
+	antes         V
+	existiaAntes  bool
+	despues       V
+	existeDespues bool
+}
+
+type diccionarioConHistorial[K comparable, V any] struct {
+	DiccionarioOrdenado[K, V]
+	operaciones []operacionHistorial[K, V]
+
+	// aplicadas es la cantidad de operaciones de la lista que están aplicadas; las siguientes se pueden rehacer
+	aplicadas int
+
+	// descartadas es la cantidad de operaciones quitadas del principio de la lista por el límite
+	descartadas int
+
+	// ultimaDescartada es el identificador de la última operación descartada por el límite, o 0 si no se
+	// descartó ninguna
+	ultimaDescartada uint64
+	limite           int
+	siguienteId      uint64
+}
+
+// CrearConHistorial envuelve a dic registrando hasta limite operaciones, que debe ser al menos 1. Al superarlo
+// se descartan las más antiguas, que ya no podrán deshacerse. A partir de este momento dic sólo debe
+// modificarse a través del diccionario devuelto
+func CrearConHistorial[K comparable, V any](dic DiccionarioOrdenado[K, V], limite int) DiccionarioConHistorial[K, V] {
+	if limite < 1 {
+		panic("El limite del historial debe ser al menos 1")
+	}
+	return &diccionarioConHistorial[K, V]{DiccionarioOrdenado: dic, limite: limite, siguienteId: 1}
+}
+
+// registrar agrega la operación al historial, descartando las que se podían rehacer y las que excedan el límite
+func (d *diccionarioConHistorial[K, V]) registrar(operacion operacionHistorial[K, V]) {
+	operacion.id = d.siguienteId
+	d.siguienteId++
+	d.operaciones = append(d.operaciones[:d.aplicadas], operacion)
+	if len(d.operaciones) > d.limite {
+		exceso := len(d.operaciones) - d.limite
+		d.ultimaDescartada = d.operaciones[exceso-1].id
+		clear(d.operaciones[:exceso])
+		d.operaciones = d.operaciones[exceso:]
+		d.descartadas += exceso
+	}
+	d.aplicadas = len(d.operaciones)
+}
+
+// llevarA deja la clave en el estado indicado, sin registrarlo
+func (d *diccionarioConHistorial[K, V]) llevarA(clave K, dato V, existe bool) {
+	if existe {
+		d.DiccionarioOrdenado.Guardar(clave, dato)
+	} else if d.DiccionarioOrdenado.Pertenece(clave) {
+		d.DiccionarioOrdenado.Borrar(clave)
+	}
+}
+
+func (d *diccionarioConHistorial[K, V]) Guardar(clave K, dato V) {
+	operacion := operacionHistorial[K, V]{clave: clave, despues: dato, existeDespues: true}
+	if d.DiccionarioOrdenado.Pertenece(clave) {
+		operacion.antes, operacion.existiaAntes = d.DiccionarioOrdenado.Obtener(clave), true
+	}
+	d.DiccionarioOrdenado.Guardar(clave, dato)
+	d.registrar(operacion)
+}
+
+func (d *diccionarioConHistorial[K, V]) Borrar(clave K) V {
+	borrado := d.DiccionarioOrdenado.Borrar(clave)
+	d.registrar(operacionHistorial[K, V]{clave: clave, antes: borrado, existiaAntes: true})
+	return borrado
+}
+
+func (d *diccionarioConHistorial[K, V]) PuedeDeshacer() bool {
+	return d.aplicadas > 0
+}
+
+func (d *diccionarioConHistorial[K, V]) PuedeRehacer() bool {
+	return d.aplicadas < len(d.operaciones)
+}
+
+func (d *diccionarioConHistorial[K, V]) Deshacer() {
+	if !d.PuedeDeshacer() {
+		panic("No hay operaciones para deshacer")
+	}
+	d.aplicadas--
+	operacion := d.operaciones[d.aplicadas]
+	d.llevarA(operacion.clave, operacion.antes, operacion.existiaAntes)
+}
+
+func (d *diccionarioConHistorial[K, V]) Rehacer() {
+	if !d.PuedeRehacer() {
+		panic("No hay operaciones para rehacer")
+	}
+	operacion := d.operaciones[d.aplicadas]
+	d.aplicadas++
+	d.llevarA(operacion.clave, operacion.despues, operacion.existeDespues)
+}
+
+func (d *diccionarioConHistorial[K, V]) Marcar() Marca {
+	return Marca{posicion: d.descartadas + d.aplicadas, ultima: d.ultimaAplicadaEn(d.aplicadas)}
+}
+
+// ultimaAplicadaEn devuelve el identificador de la última operación aplicada cuando hay aplicadas operaciones
+// de la lista. Si no hay ninguna, es la última descartada
+func (d *diccionarioConHistorial[K, V]) ultimaAplicadaEn(aplicadas int) uint64 {
+	if aplicadas == 0 {
+		return d.ultimaDescartada
+	}
+	return d.operaciones[aplicadas-1].id
+}
+
+func (d *diccionarioConHistorial[K, V]) VolverA(marca Marca) error {
+	objetivo := marca.posicion - d.descartadas
+	if objetivo < 0 || objetivo > len(d.operaciones) {
+		return ErrMarcaInvalida
+	}
+	if d.ultimaAplicadaEn(objetivo) != marca.ultima {
+		return ErrMarcaInvalida
+	}
+	for d.aplicadas > objetivo {
+		d.Deshacer()
+	}
+	for d.aplicadas < objetivo {
+		d.Rehacer()
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package diccionario_test
+
+import (
+	"cmp"
+	TDADiccionario "tdas/diccionario"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func crearConHistorial(limite int) TDADiccionario.DiccionarioConHistorial[string, int] {
+	return TDADiccionario.CrearConHistorial[string, int](TDADiccionario.CrearABB[string, int](cmp.Compare), limite)
+}
+
+func TestHistorialDeshacerRehacer(t *testing.T) {
+	t.Log("Deshacer revierte inserciones, sobrescrituras y borrados, y Rehacer los vuelve a aplicar")
+	dic := crearConHistorial(10)
+	require.False(t, dic.PuedeDeshacer())
+	dic.Guardar("A", 1)
+	dic.Guardar("A", 2)
+	dic.Guardar("B", 3)
+	dic.Borrar("A")
+
+	dic.Deshacer()
+	require.EqualValues(t, 2, dic.Obtener("A"))
+	dic.Deshacer()
+	require.False(t, dic.Pertenece("B"))
+	dic.Deshacer()
+	require.EqualValues(t, 1, dic.Obtener("A"))
+	dic.Deshacer()
+	require.EqualValues(t, 0, dic.Cantidad())
+	require.PanicsWithValue(t, "No hay operaciones para deshacer", func() { dic.Deshacer() })
+
+	for dic.PuedeRehacer() {
+		dic.Rehacer()
+	}
+	require.False(t, dic.Pertenece("A"))
+	require.EqualValues(t, 3, dic.Obtener("B"))
+	require.PanicsWithValue(t, "No hay operaciones para rehacer", func() { dic.Rehacer() })
+}
+
+func TestHistorialModificarDescartaRehacer(t *testing.T) {
+	t.Log("Modificar después de deshacer descarta las operaciones que se podían rehacer")
+	dic := crearConHistorial(10)
+	dic.Guardar("A", 1)
+	dic.Guardar("B", 2)
+	dic.Deshacer()
+	require.True(t, dic.PuedeRehacer())
+	dic.Guardar("C", 3)
+	require.False(t, dic.PuedeRehacer())
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar("B") })
+	dic.Deshacer()
+	dic.Deshacer()
+	require.EqualValues(t, 0, dic.Cantidad())
+}
+
+func TestHistorialMarcas(t *testing.T) {
+	t.Log("VolverA deshace o rehace hasta una marca, y rechaza las marcas de operaciones descartadas")
+	dic := crearConHistorial(10)
+	inicio := dic.Marcar()
+	dic.Guardar("A", 1)
+	dic.Guardar("B", 2)
+	medio := dic.Marcar()
+	dic.Guardar("A", 10)
+	dic.Borrar("B")
+	final := dic.Marcar()
+
+	require.NoError(t, dic.VolverA(medio))
+	require.EqualValues(t, 1, dic.Obtener("A"))
+	require.EqualValues(t, 2, dic.Obtener("B"))
+	require.NoError(t, dic.VolverA(final))
+	require.False(t, dic.Pertenece("B"))
+	require.NoError(t, dic.VolverA(inicio))
+	require.EqualValues(t, 0, dic.Cantidad())
+
+	require.NoError(t, dic.VolverA(medio))
+	dic.Guardar("C", 3)
+	require.ErrorIs(t, dic.VolverA(final), TDADiccionario.ErrMarcaInvalida)
+	require.NoError(t, dic.VolverA(medio))
+	require.False(t, dic.Pertenece("C"))
+}
+
+func TestHistorialLimite(t *testing.T) {
+	t.Log("El historial conserva sólo las últimas operaciones")
+	dic := crearConHistorial(3)
+	inicio := dic.Marcar()
+	for i := 0; i < 5; i++ {
+		dic.Guardar("A", i)
+	}
+	for dic.PuedeDeshacer() {
+		dic.Deshacer()
+	}
+	require.EqualValues(t, 1, dic.Obtener("A"))
+	require.ErrorIs(t, dic.VolverA(inicio), TDADiccionario.ErrMarcaInvalida)
+
+	// El estado en el límite de lo descartado sigue siendo alcanzable
+	limite := dic.Marcar()
+	dic.Rehacer()
+	dic.Rehacer()
+	require.NoError(t, dic.VolverA(limite))
+	require.EqualValues(t, 1, dic.Obtener("A"))
+
+	conservada := crearConHistorial(2)
+	conservada.Guardar("A", 1)
+	primera := conservada.Marcar()
+	conservada.Guardar("A", 2)
+	conservada.Guardar("A", 3)
+	require.NoError(t, conservada.VolverA(primera))
+	require.EqualValues(t, 1, conservada.Obtener("A"))
+	require.PanicsWithValue(t, "El limite del historial debe ser al menos 1", func() { crearConHistorial(0) })
+}
@@ -0,0 +1,91 @@
+package diccionario
+
+import (
+	"expvar"
+	"fmt"
+	"io"
+	"sync/atomic"
+)
+
+// Metricas acumula contadores de las operaciones internas de los diccionarios creados con ConMetricas. Los
+// contadores son atómicos, por lo que una misma Metricas puede compartirse entre varios diccionarios y leerse
+// mientras se usan
+type Metricas struct {
+	// Comparaciones cuenta las llamadas a la función de comparación
+	Comparaciones atomic.Int64
+
+	// NodosCreados cuenta los nodos reservados al guardar claves nuevas
+	NodosCreados atomic.Int64
+
+	// Rotaciones cuenta las rotaciones simples del splay. Es el único de los diccionarios que aceptan ConMetricas
+	// que rota: en un abb queda siempre en 0
+	Rotaciones atomic.Int64
+
+	// Iteradores cuenta los iteradores externos creados con Iterador o IteradorRango
+	Iteradores atomic.Int64
+}
+
+// ValoresMetricas es una copia de los contadores de Metricas en un momento dado
+type ValoresMetricas struct {
+	Comparaciones int64
+	NodosCreados  int64
+	Rotaciones    int64
+	Iteradores    int64
+}
+
+// ConMetricas hace que el diccionario acumule sus contadores en metricas. Sin esta opción el diccionario no
+// envuelve la función de comparación ni actualiza contadores, por lo que no tiene costo adicional
+func ConMetricas(metricas *Metricas) OpcionABB {
+	return func(config *configuracionABB) {
+		config.metricas = metricas
+	}
+}
+
+// contarComparaciones envuelve a la función de comparación para contar sus llamadas
+func contarComparaciones[K any](cmp func(K, K) int, metricas *Metricas) func(K, K) int {
+	return func(a, b K) int {
+		metricas.Comparaciones.Add(1)
+		return cmp(a, b)
+	}
+}
+
+// Valores devuelve los valores actuales de los contadores. Cada contador se lee atómicamente, pero no todos en
+// el mismo instante
+func (m *Metricas) Valores() ValoresMetricas {
+	return ValoresMetricas{
+		Comparaciones: m.Comparaciones.Load(),
+		NodosCreados:  m.NodosCreados.Load(),
+		Rotaciones:    m.Rotaciones.Load(),
+		Iteradores:    m.Iteradores.Load(),
+	}
+}
+
+// Publicar publica las métricas en expvar con el nombre indicado, como un objeto con un campo por contador. Al
+// igual que expvar.Publish, entra en pánico si el nombre ya fue publicado
+func (m *Metricas) Publicar(nombre string) {
+	expvar.Publish(nombre, expvar.Func(func() any { return m.Valores() }))
+}
+
+// EscribirPrometheus escribe los contadores en el formato de texto de Prometheus, cada uno como un counter
+// llamado prefijo_<contador>_total. El prefijo debe ser un nombre de métrica válido
+func (m *Metricas) EscribirPrometheus(w io.Writer, prefijo string) error {
+	valores := m.Valores()
+	contadores := []struct {
+		nombre      string
+		descripcion string
+		valor       int64
+	}{
+		{"comparaciones", "Llamadas a la funcion de comparacion", valores.Comparaciones},
+		{"nodos_creados", "Nodos reservados al guardar claves nuevas", valores.NodosCreados},
+		{"rotaciones", "Rotaciones simples realizadas por el splay", valores.Rotaciones},
+		{"iteradores", "Iteradores externos creados", valores.Iteradores},
+	}
+	for _, contador := range contadores {
+		nombre := prefijo + "_" + contador.nombre + "_total"
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s counter\n%s %d\n",
+			nombre, contador.descripcion, nombre, nombre, contador.valor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
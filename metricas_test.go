@@ -0,0 +1,89 @@
+package diccionario_test
+
+import (
+	"cmp"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"strings"
+	TDADiccionario "tdas/diccionario"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricasABB(t *testing.T) {
+	t.Log("El ABB cuenta comparaciones, nodos creados e iteradores externos")
+	metricas := &TDADiccionario.Metricas{}
+	dic := TDADiccionario.CrearABB[int, int](cmp.Compare, TDADiccionario.ConMetricas(metricas))
+	dic.Guardar(2, 2)
+	dic.Guardar(1, 1)
+	dic.Guardar(3, 3)
+	dic.Guardar(3, 30)
+	require.EqualValues(t, TDADiccionario.ValoresMetricas{Comparaciones: 4, NodosCreados: 3}, metricas.Valores())
+
+	require.True(t, dic.Pertenece(1))
+	require.EqualValues(t, 6, metricas.Comparaciones.Load())
+
+	dic.Iterador()
+	dic.IteradorRango(nil, nil)
+	dic.Iterar(func(int, int) bool { return true })
+	require.EqualValues(t, 2, metricas.Iteradores.Load())
+	require.EqualValues(t, 0, metricas.Rotaciones.Load())
+
+	antes := metricas.Comparaciones.Load()
+	require.NoError(t, dic.Validar())
+	require.EqualValues(t, antes, metricas.Comparaciones.Load())
+}
+
+func TestMetricasSplay(t *testing.T) {
+	t.Log("El splay cuenta además sus rotaciones, y la iteración interna no cuenta como iterador")
+	metricas := &TDADiccionario.Metricas{}
+	dic := TDADiccionario.CrearSplay[int, int](cmp.Compare, TDADiccionario.ConMetricas(metricas))
+	for i := 0; i < 10; i++ {
+		dic.Guardar(i, i)
+	}
+	require.EqualValues(t, 10, metricas.NodosCreados.Load())
+	dic.Obtener(0)
+	require.Greater(t, metricas.Rotaciones.Load(), int64(0))
+	dic.Iterar(func(int, int) bool { return true })
+	require.EqualValues(t, 0, metricas.Iteradores.Load())
+	dic.Iterador()
+	require.EqualValues(t, 1, metricas.Iteradores.Load())
+}
+
+func TestMetricasCompartidas(t *testing.T) {
+	t.Log("Una misma Metricas acumula los contadores de varios diccionarios")
+	metricas := &TDADiccionario.Metricas{}
+	primero := TDADiccionario.CrearABB[int, int](cmp.Compare, TDADiccionario.ConMetricas(metricas))
+	segundo := TDADiccionario.CrearABB[int, int](cmp.Compare, TDADiccionario.ConMetricas(metricas),
+		TDADiccionario.ConValidacionComparador())
+	primero.Guardar(1, 1)
+	segundo.Guardar(1, 1)
+	segundo.Guardar(2, 2)
+	require.EqualValues(t, 3, metricas.NodosCreados.Load())
+	require.EqualValues(t, 1, metricas.Comparaciones.Load())
+}
+
+func TestMetricasExportar(t *testing.T) {
+	t.Log("Las métricas se exportan en formato de texto de Prometheus y como variable de expvar")
+	metricas := &TDADiccionario.Metricas{}
+	dic := TDADiccionario.CrearABB[int, int](cmp.Compare, TDADiccionario.ConMetricas(metricas))
+	dic.Guardar(1, 1)
+	dic.Guardar(2, 2)
+
+	var b strings.Builder
+	require.NoError(t, metricas.EscribirPrometheus(&b, "catalogo"))
+	require.Contains(t, b.String(), "# TYPE catalogo_comparaciones_total counter\ncatalogo_comparaciones_total 1\n")
+	require.Contains(t, b.String(), "catalogo_nodos_creados_total 2\n")
+	require.Contains(t, b.String(), "catalogo_rotaciones_total 0\n")
+	require.Contains(t, b.String(), "catalogo_iteradores_total 0\n")
+
+	// expvar no permite publicar dos veces el mismo nombre, y la prueba puede ejecutarse varias veces
+	nombre := fmt.Sprintf("%s_%d", t.Name(), time.Now().UnixNano())
+	metricas.Publicar(nombre)
+	var valores TDADiccionario.ValoresMetricas
+	require.NoError(t, json.Unmarshal([]byte(expvar.Get(nombre).String()), &valores))
+	require.EqualValues(t, metricas.Valores(), valores)
+}
@@ -0,0 +1,153 @@
+package diccionario
+
+// MultiDiccionarioOrdenado es un diccionario ordenado que admite varios datos por clave. Los datos de una misma
+// clave se mantienen en el orden en que fueron guardados
+type MultiDiccionarioOrdenado[K comparable, V any] interface {
+	// Guardar agrega el dato a los de la clave, sin reemplazar a los anteriores
+	Guardar(clave K, dato V)
+
+	// Pertenece determina si la clave tiene al menos un dato
+	Pertenece(clave K) bool
+
+	// ObtenerTodos devuelve una copia de los datos de la clave en orden de inserción. Si la clave no pertenece,
+	// devuelve un slice vacío
+	ObtenerTodos(clave K) []V
+
+	// Borrar quita y devuelve el dato más antiguo de la clave. Si la clave no pertenece, entra en pánico con un
+	// mensaje 'La clave no pertenece al diccionario'
+	Borrar(clave K) V
+
+	// BorrarTodos quita y devuelve todos los datos de la clave en orden de inserción. Si la clave no pertenece,
+	// entra en pánico con un mensaje 'La clave no pertenece al diccionario'
+	BorrarTodos(clave K) []V
+
+	// Cantidad devuelve la cantidad total de pares (clave, dato)
+	Cantidad() int
+
+	// CantidadClaves devuelve la cantidad de claves distintas
+	CantidadClaves() int
+
+	// Iterar visita cada par (clave, dato), ordenados por clave y, dentro de una clave, por orden de inserción
+	Iterar(visitar func(clave K, dato V) bool)
+
+	// Iterador devuelve un IterDiccionario que recorre los pares en el mismo orden que Iterar
+	Iterador() IterDiccionario[K, V]
+
+	// IterarRango es como Iterar, pero sólo con las claves comprendidas en el rango indicado
+	IterarRango(desde *K, hasta *K, visitar func(clave K, dato V) bool)
+
+	// IteradorRango es como Iterador, pero sólo con las claves comprendidas en el rango indicado
+	IteradorRango(desde *K, hasta *K) IterDiccionario[K, V]
+}
+
+type multiDiccionario[K comparable, V any] struct {
+	dic      DiccionarioOrdenado[K, []V]
+	cantidad int
+}
+
+// CrearMultiABB crea un MultiDiccionarioOrdenado implementado sobre un abb cuyos datos son los slices de datos de
+// cada clave
+func CrearMultiABB[K comparable, V any](cmp func(K, K) int) MultiDiccionarioOrdenado[K, V] {
+	return &multiDiccionario[K, V]{dic: CrearABB[K, []V](cmp)}
+}
+
+func (m *multiDiccionario[K, V]) Guardar(clave K, dato V) {
+	var datos []V
+	if m.dic.Pertenece(clave) {
+		datos = m.dic.Obtener(clave)
+	}
+	m.dic.Guardar(clave, append(datos, dato))
+	m.cantidad++
+}
+
+func (m *multiDiccionario[K, V]) Pertenece(clave K) bool {
+	return m.dic.Pertenece(clave)
+}
+
+func (m *multiDiccionario[K, V]) ObtenerTodos(clave K) []V {
+	if !m.dic.Pertenece(clave) {
+		return []V{}
+	}
+	return append([]V(nil), m.dic.Obtener(clave)...)
+}
+
+func (m *multiDiccionario[K, V]) Borrar(clave K) V {
+	datos := m.dic.Obtener(clave)
+	borrado := datos[0]
+	if len(datos) == 1 {
+		m.dic.Borrar(clave)
+	} else {
+		var cero V
+		datos[0] = cero
+		m.dic.Guardar(clave, datos[1:])
+	}
+	m.cantidad--
+	return borrado
+}
+
+func (m *multiDiccionario[K, V]) BorrarTodos(clave K) []V {
+	datos := m.dic.Borrar(clave)
+	m.cantidad -= len(datos)
+	return datos
+}
+
+func (m *multiDiccionario[K, V]) Cantidad() int {
+	return m.cantidad
+}
+
+func (m *multiDiccionario[K, V]) CantidadClaves() int {
+	return m.dic.Cantidad()
+}
+
+func (m *multiDiccionario[K, V]) Iterar(visitar func(K, V) bool) {
+	m.IterarRango(nil, nil, visitar)
+}
+
+func (m *multiDiccionario[K, V]) IterarRango(desde *K, hasta *K, visitar func(K, V) bool) {
+	m.dic.IterarRango(desde, hasta, func(clave K, datos []V) bool {
+		for _, dato := range datos {
+			if !visitar(clave, dato) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// iteradorMulti recorre los datos de la clave actual del iterador de claves antes de avanzarlo
+type iteradorMulti[K comparable, V any] struct {
+	claves IterDiccionario[K, []V]
+	pos    int
+}
+
+func (m *multiDiccionario[K, V]) Iterador() IterDiccionario[K, V] {
+	return m.IteradorRango(nil, nil)
+}
+
+func (m *multiDiccionario[K, V]) IteradorRango(desde *K, hasta *K) IterDiccionario[K, V] {
+	return &iteradorMulti[K, V]{claves: m.dic.IteradorRango(desde, hasta)}
+}
+
+func (iter *iteradorMulti[K, V]) HaySiguiente() bool {
+	return iter.claves.HaySiguiente()
+}
+
+func (iter *iteradorMulti[K, V]) VerActual() (K, V) {
+	if !iter.HaySiguiente() {
+		panic("El iterador termino de iterar")
+	}
+	clave, datos := iter.claves.VerActual()
+	return clave, datos[iter.pos]
+}
+
+func (iter *iteradorMulti[K, V]) Siguiente() {
+	if !iter.HaySiguiente() {
+		panic("El iterador termino de iterar")
+	}
+	_, datos := iter.claves.VerActual()
+	iter.pos++
+	if iter.pos == len(datos) {
+		iter.pos = 0
+		iter.claves.Siguiente()
+	}
+}
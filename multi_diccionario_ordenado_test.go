@@ -0,0 +1,96 @@
+package diccionario_test
+
+import (
+	"cmp"
+	"strings"
+	TDADiccionario "tdas/diccionario"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type parMulti struct {
+	clave int
+	dato  string
+}
+
+func TestMultiDiccionarioVacio(t *testing.T) {
+	t.Log("Comprueba que un multidiccionario vacío no tiene claves")
+	dic := TDADiccionario.CrearMultiABB[string, int](strings.Compare)
+	require.EqualValues(t, 0, dic.Cantidad())
+	require.EqualValues(t, 0, dic.CantidadClaves())
+	require.False(t, dic.Pertenece("A"))
+	require.Empty(t, dic.ObtenerTodos("A"))
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar("A") })
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.BorrarTodos("A") })
+	require.False(t, dic.Iterador().HaySiguiente())
+}
+
+func TestMultiDiccionarioClavesRepetidas(t *testing.T) {
+	t.Log("Guardar con una clave existente agrega el dato, y Borrar quita los datos en orden de inserción")
+	dic := TDADiccionario.CrearMultiABB[int, string](cmp.Compare)
+	dic.Guardar(10, "a")
+	dic.Guardar(5, "b")
+	dic.Guardar(10, "c")
+	dic.Guardar(10, "d")
+	require.EqualValues(t, 4, dic.Cantidad())
+	require.EqualValues(t, 2, dic.CantidadClaves())
+	require.EqualValues(t, []string{"a", "c", "d"}, dic.ObtenerTodos(10))
+
+	// Modificar el slice devuelto no modifica al diccionario
+	dic.ObtenerTodos(10)[0] = "z"
+	require.EqualValues(t, "a", dic.Borrar(10))
+	require.EqualValues(t, []string{"c", "d"}, dic.ObtenerTodos(10))
+	require.EqualValues(t, 3, dic.Cantidad())
+
+	require.EqualValues(t, []string{"c", "d"}, dic.BorrarTodos(10))
+	require.False(t, dic.Pertenece(10))
+	require.EqualValues(t, 1, dic.Cantidad())
+	require.EqualValues(t, "b", dic.Borrar(5))
+	require.EqualValues(t, 0, dic.Cantidad())
+	require.EqualValues(t, 0, dic.CantidadClaves())
+}
+
+func TestMultiDiccionarioIteracion(t *testing.T) {
+	t.Log("Las iteraciones recorren cada par por clave y luego por orden de inserción")
+	dic := TDADiccionario.CrearMultiABB[int, string](cmp.Compare)
+	dic.Guardar(3, "x")
+	dic.Guardar(1, "a")
+	dic.Guardar(3, "y")
+	dic.Guardar(2, "b")
+	dic.Guardar(1, "c")
+	dic.Guardar(3, "z")
+
+	todos := []parMulti{{1, "a"}, {1, "c"}, {2, "b"}, {3, "x"}, {3, "y"}, {3, "z"}}
+	internos := []parMulti{}
+	dic.Iterar(func(clave int, dato string) bool {
+		internos = append(internos, parMulti{clave, dato})
+		return true
+	})
+	require.EqualValues(t, todos, internos)
+
+	externos := []parMulti{}
+	for iter := dic.Iterador(); iter.HaySiguiente(); iter.Siguiente() {
+		clave, dato := iter.VerActual()
+		externos = append(externos, parMulti{clave, dato})
+	}
+	require.EqualValues(t, todos, externos)
+
+	desde, hasta := 2, 3
+	enRango := []parMulti{}
+	iter := dic.IteradorRango(&desde, &hasta)
+	for iter.HaySiguiente() {
+		clave, dato := iter.VerActual()
+		enRango = append(enRango, parMulti{clave, dato})
+		iter.Siguiente()
+	}
+	require.EqualValues(t, todos[2:], enRango)
+	require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.Siguiente() })
+
+	cortados := []parMulti{}
+	dic.IterarRango(nil, &desde, func(clave int, dato string) bool {
+		cortados = append(cortados, parMulti{clave, dato})
+		return dato != "c"
+	})
+	require.EqualValues(t, todos[:2], cortados)
+}
@@ -0,0 +1,477 @@
+package diccionario
+
+import (
+	"errors"
+	"sync"
+
+	TDAPila "tdas/pila"
+)
+
+// DiccionarioMVCC es un diccionario ordenado multiversión: cada actualización confirmada produce una versión
+// nueva, con un número mayor a todas las anteriores, y las versiones publicadas nunca cambian. Un lector fija
+// una versión y puede consultarla e iterarla sin bloquear a los escritores ni ser afectado por ellos.
+//
+// Se conservan siempre las últimas versiones (según la retención indicada al crearlo) y además todas las que
+// tengan alguna Lectura sin liberar. El resto se descarta, y la memoria de los nodos que sólo usaban ellas queda
+// para el recolector de basura. Todas las primitivas pueden usarse desde varias goroutines a la vez.
+type DiccionarioMVCC[K comparable, V any] interface {
+	// Actualizar ejecuta modificar sobre un DiccionarioOrdenado con el contenido de la última versión, y al
+	// terminar publica todos sus cambios juntos como una versión nueva, cuyo número devuelve. Si modificar entra
+	// en pánico no se publica nada. Las actualizaciones se ejecutan de a una, pero no esperan a los lectores. El
+	// diccionario recibido sólo puede usarse dentro de modificar
+	Actualizar(modificar func(dic DiccionarioOrdenado[K, V])) uint64
+
+	// VersionActual devuelve el número de la última versión publicada. La versión inicial, vacía, es la 0
+	VersionActual() uint64
+
+	// Leer fija la última versión publicada
+	Leer() Lectura[K, V]
+
+	// LeerVersion fija la versión indicada, o devuelve ErrVersionNoDisponible si no existe o ya fue descartada
+	LeerVersion(version uint64) (Lectura[K, V], error)
+}
+
+// Lectura es una versión fija de un DiccionarioMVCC, con las primitivas de consulta de DiccionarioOrdenado.
+// Mientras no se libere, la versión no se descarta. Una vez liberada, cualquier primitiva (incluidas las de sus
+// iteradores) entra en pánico con un mensaje 'La lectura ya fue liberada'
+type Lectura[K comparable, V any] interface {
+	// Version devuelve el número de la versión fijada
+	Version() uint64
+
+	Pertenece(clave K) bool
+	Obtener(clave K) V
+	Cantidad() int
+	Iterar(visitar func(clave K, dato V) bool)
+	Iterador() IterDiccionario[K, V]
+	IterarRango(desde *K, hasta *K, visitar func(clave K, dato V) bool)
+	IteradorRango(desde *K, hasta *K) IterDiccionario[K, V]
+
+	// Liberar indica que la lectura ya no se usará, permitiendo descartar su versión
+	Liberar()
+}
+
+// ErrVersionNoDisponible indica que la versión pedida todavía no existe o ya fue descartada
+var ErrVersionNoDisponible = errors.New("la version no esta disponible")
+
+// nodoMVCC es un nodo de un AVL persistente. Una vez publicada la versión que lo creó no se modifica más: las
+// actualizaciones copian los nodos del camino que cambian
+type nodoMVCC[K comparable, V any] struct {
+	clave   K
+	dato    V
+	izq     *nodoMVCC[K, V]
+	der     *nodoMVCC[K, V]
+	altura  int
+	version uint64
+}
+
+// versionMVCC es una versión publicada junto con la cantidad de lecturas que la fijan
+type versionMVCC[K comparable, V any] struct {
+	raiz       *nodoMVCC[K, V]
+	cantidad   int
+	fijaciones int
+}
+
+type diccionarioMVCC[K comparable, V any] struct {
+	cmp       func(K, K) int
+	retencion uint64
+
+	// escritura serializa las actualizaciones
+	escritura sync.Mutex
+
+	// versiones protege a actual y publicadas, y a las fijaciones de cada versión
+	versiones  sync.Mutex
+	actual     uint64
+	publicadas map[uint64]*versionMVCC[K, V]
+}
+
+// CrearMVCC crea un DiccionarioMVCC vacío que conserva siempre las últimas retencion versiones, que debe ser al
+// menos 1. Leer y actualizar cuestan O(log n); cada actualización reserva O(log n) nodos por clave modificada
+func CrearMVCC[K comparable, V any](cmp func(K, K) int, retencion int) DiccionarioMVCC[K, V] {
+	if retencion < 1 {
+		panic("La retencion debe ser al menos 1")
+	}
+	return &diccionarioMVCC[K, V]{
+		cmp:        cmp,
+		retencion:  uint64(retencion),
+		publicadas: map[uint64]*versionMVCC[K, V]{0: {}},
+	}
+}
+
+func (d *diccionarioMVCC[K, V]) Actualizar(modificar func(DiccionarioOrdenado[K, V])) uint64 {
+	d.escritura.Lock()
+	defer d.escritura.Unlock()
+
+	d.versiones.Lock()
+	ultima := d.publicadas[d.actual]
+	nueva := d.actual + 1
+	d.versiones.Unlock()
+
+	escritura := &escrituraMVCC[K, V]{
+		lecturaMVCC: lecturaMVCC[K, V]{
+			raiz:            ultima.raiz,
+			cantidad:        ultima.cantidad,
+			cmp:             d.cmp,
+			mensajeLiberada: "La actualizacion ya termino",
+		},
+		version: nueva,
+	}
+	defer func() { escritura.liberada = true }()
+	modificar(escritura)
+
+	d.versiones.Lock()
+	defer d.versiones.Unlock()
+	d.actual = nueva
+	d.publicadas[nueva] = &versionMVCC[K, V]{raiz: escritura.raiz, cantidad: escritura.cantidad}
+	if nueva >= d.retencion {
+		d.descartar(nueva - d.retencion)
+	}
+	return nueva
+}
+
+// descartar elimina la versión si ya quedó fuera de la retención y no está fijada. Requiere tener el lock de
+// versiones
+func (d *diccionarioMVCC[K, V]) descartar(version uint64) {
+	publicada, ok := d.publicadas[version]
+	if ok && publicada.fijaciones == 0 && version+d.retencion <= d.actual {
+		delete(d.publicadas, version)
+	}
+}
+
+func (d *diccionarioMVCC[K, V]) VersionActual() uint64 {
+	d.versiones.Lock()
+	defer d.versiones.Unlock()
+	return d.actual
+}
+
+func (d *diccionarioMVCC[K, V]) Leer() Lectura[K, V] {
+	d.versiones.Lock()
+	defer d.versiones.Unlock()
+	return d.fijar(d.actual, d.publicadas[d.actual])
+}
+
+func (d *diccionarioMVCC[K, V]) LeerVersion(version uint64) (Lectura[K, V], error) {
+	d.versiones.Lock()
+	defer d.versiones.Unlock()
+	publicada, ok := d.publicadas[version]
+	if !ok {
+		return nil, ErrVersionNoDisponible
+	}
+	return d.fijar(version, publicada), nil
+}
+
+// fijar crea una Lectura de la versión publicada. Requiere tener el lock de versiones
+func (d *diccionarioMVCC[K, V]) fijar(version uint64, publicada *versionMVCC[K, V]) Lectura[K, V] {
+	publicada.fijaciones++
+	return &lecturaFijada[K, V]{
+		lecturaMVCC: lecturaMVCC[K, V]{
+			raiz:            publicada.raiz,
+			cantidad:        publicada.cantidad,
+			cmp:             d.cmp,
+			mensajeLiberada: "La lectura ya fue liberada",
+		},
+		dic:     d,
+		version: version,
+	}
+}
+
+// lecturaMVCC implementa las consultas sobre una raíz del AVL persistente
+type lecturaMVCC[K comparable, V any] struct {
+	raiz     *nodoMVCC[K, V]
+	cantidad int
+	cmp      func(K, K) int
+	liberada bool
+
+	// mensajeLiberada es el mensaje del pánico al usarla después de liberada
+	mensajeLiberada string
+}
+
+func (l *lecturaMVCC[K, V]) verificarVigente() {
+	if l.liberada {
+		panic(l.mensajeLiberada)
+	}
+}
+
+func (l *lecturaMVCC[K, V]) buscar(clave K) *nodoMVCC[K, V] {
+	n := l.raiz
+	for n != nil {
+		cmp := l.cmp(clave, n.clave)
+		if cmp == 0 {
+			return n
+		}
+		if cmp < 0 {
+			n = n.izq
+		} else {
+			n = n.der
+		}
+	}
+	return nil
+}
+
+func (l *lecturaMVCC[K, V]) Pertenece(clave K) bool {
+	l.verificarVigente()
+	return l.buscar(clave) != nil
+}
+
+func (l *lecturaMVCC[K, V]) Obtener(clave K) V {
+	l.verificarVigente()
+	n := l.buscar(clave)
+	if n == nil {
+		panic("La clave no pertenece al diccionario")
+	}
+	return n.dato
+}
+
+func (l *lecturaMVCC[K, V]) Cantidad() int {
+	l.verificarVigente()
+	return l.cantidad
+}
+
+func (l *lecturaMVCC[K, V]) Iterar(visitar func(K, V) bool) {
+	l.IterarRango(nil, nil, visitar)
+}
+
+func (l *lecturaMVCC[K, V]) IterarRango(desde *K, hasta *K, visitar func(K, V) bool) {
+	l.verificarVigente()
+	l.iterarRango(l.raiz, desde, hasta, visitar)
+}
+
+func (l *lecturaMVCC[K, V]) iterarRango(n *nodoMVCC[K, V], desde *K, hasta *K, visitar func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	mayorADesde := desde == nil || l.cmp(n.clave, *desde) >= 0
+	menorAHasta := hasta == nil || l.cmp(n.clave, *hasta) <= 0
+	if mayorADesde && !l.iterarRango(n.izq, desde, hasta, visitar) {
+		return false
+	}
+	if mayorADesde && menorAHasta && !visitar(n.clave, n.dato) {
+		return false
+	}
+	if menorAHasta {
+		return l.iterarRango(n.der, desde, hasta, visitar)
+	}
+	return true
+}
+
+// iteradorMVCC recorre una versión con una pila, igual que el iterador del abb. Como los nodos de una versión
+// publicada no cambian, las actualizaciones posteriores no lo afectan
+type iteradorMVCC[K comparable, V any] struct {
+	lectura *lecturaMVCC[K, V]
+	pila    TDAPila.Pila[*nodoMVCC[K, V]]
+	desde   *K
+	hasta   *K
+}
+
+func (l *lecturaMVCC[K, V]) Iterador() IterDiccionario[K, V] {
+	return l.IteradorRango(nil, nil)
+}
+
+func (l *lecturaMVCC[K, V]) IteradorRango(desde *K, hasta *K) IterDiccionario[K, V] {
+	l.verificarVigente()
+	iter := &iteradorMVCC[K, V]{lectura: l, pila: TDAPila.CrearPilaDinamica[*nodoMVCC[K, V]](), desde: desde, hasta: hasta}
+	iter.apilar(l.raiz)
+	return iter
+}
+
+func (iter *iteradorMVCC[K, V]) apilar(n *nodoMVCC[K, V]) {
+	for n != nil {
+		if iter.desde != nil && iter.lectura.cmp(n.clave, *iter.desde) < 0 {
+			n = n.der
+		} else if iter.hasta != nil && iter.lectura.cmp(n.clave, *iter.hasta) > 0 {
+			n = n.izq
+		} else {
+			iter.pila.Apilar(n)
+			n = n.izq
+		}
+	}
+}
+
+func (iter *iteradorMVCC[K, V]) HaySiguiente() bool {
+	iter.lectura.verificarVigente()
+	return !iter.pila.EstaVacia()
+}
+
+func (iter *iteradorMVCC[K, V]) VerActual() (K, V) {
+	if !iter.HaySiguiente() {
+		panic("El iterador termino de iterar")
+	}
+	n := iter.pila.VerTope()
+	return n.clave, n.dato
+}
+
+func (iter *iteradorMVCC[K, V]) Siguiente() {
+	if !iter.HaySiguiente() {
+		panic("El iterador termino de iterar")
+	}
+	iter.apilar(iter.pila.Desapilar().der)
+}
+
+// lecturaFijada es una Lectura que mantiene fijada su versión hasta ser liberada
+type lecturaFijada[K comparable, V any] struct {
+	lecturaMVCC[K, V]
+	dic     *diccionarioMVCC[K, V]
+	version uint64
+}
+
+func (l *lecturaFijada[K, V]) Version() uint64 {
+	return l.version
+}
+
+func (l *lecturaFijada[K, V]) Liberar() {
+	l.dic.versiones.Lock()
+	defer l.dic.versiones.Unlock()
+	if l.liberada {
+		panic("La lectura ya fue liberada")
+	}
+	l.liberada = true
+	l.dic.publicadas[l.version].fijaciones--
+	l.dic.descartar(l.version)
+}
+
+// escrituraMVCC es el DiccionarioOrdenado que recibe Actualizar. Modifica en el lugar los nodos creados por su
+// propia versión, y copia los de versiones anteriores antes de cambiarlos
+type escrituraMVCC[K comparable, V any] struct {
+	lecturaMVCC[K, V]
+	version uint64
+}
+
+func (e *escrituraMVCC[K, V]) copiar(n *nodoMVCC[K, V]) *nodoMVCC[K, V] {
+	if n.version == e.version {
+		return n
+	}
+	copia := *n
+	copia.version = e.version
+	return &copia
+}
+
+func alturaMVCC[K comparable, V any](n *nodoMVCC[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.altura
+}
+
+// rotarDerecha rota el subárbol copiando los dos nodos que cambian
+func (e *escrituraMVCC[K, V]) rotarDerecha(n *nodoMVCC[K, V]) *nodoMVCC[K, V] {
+	n = e.copiar(n)
+	hijo := e.copiar(n.izq)
+	n.izq = hijo.der
+	hijo.der = n
+	n.altura = 1 + max(alturaMVCC(n.izq), alturaMVCC(n.der))
+	hijo.altura = 1 + max(alturaMVCC(hijo.izq), alturaMVCC(hijo.der))
+	return hijo
+}
+
+func (e *escrituraMVCC[K, V]) rotarIzquierda(n *nodoMVCC[K, V]) *nodoMVCC[K, V] {
+	n = e.copiar(n)
+	hijo := e.copiar(n.der)
+	n.der = hijo.izq
+	hijo.izq = n
+	n.altura = 1 + max(alturaMVCC(n.izq), alturaMVCC(n.der))
+	hijo.altura = 1 + max(alturaMVCC(hijo.izq), alturaMVCC(hijo.der))
+	return hijo
+}
+
+// balancear recibe un nodo ya copiado, actualiza su altura y lo rota si quedó desbalanceado
+func (e *escrituraMVCC[K, V]) balancear(n *nodoMVCC[K, V]) *nodoMVCC[K, V] {
+	n.altura = 1 + max(alturaMVCC(n.izq), alturaMVCC(n.der))
+	factor := alturaMVCC(n.izq) - alturaMVCC(n.der)
+	if factor > 1 {
+		if alturaMVCC(n.izq.izq) < alturaMVCC(n.izq.der) {
+			n.izq = e.rotarIzquierda(n.izq)
+		}
+		return e.rotarDerecha(n)
+	}
+	if factor < -1 {
+		if alturaMVCC(n.der.der) < alturaMVCC(n.der.izq) {
+			n.der = e.rotarDerecha(n.der)
+		}
+		return e.rotarIzquierda(n)
+	}
+	return n
+}
+
+func (e *escrituraMVCC[K, V]) Guardar(clave K, dato V) {
+	e.verificarVigente()
+	e.raiz = e.guardarRec(e.raiz, clave, dato)
+}
+
+func (e *escrituraMVCC[K, V]) guardarRec(n *nodoMVCC[K, V], clave K, dato V) *nodoMVCC[K, V] {
+	if n == nil {
+		e.cantidad++
+		return &nodoMVCC[K, V]{clave: clave, dato: dato, altura: 1, version: e.version}
+	}
+	cmp := e.cmp(clave, n.clave)
+	n = e.copiar(n)
+	if cmp == 0 {
+		n.dato = dato
+		return n
+	}
+	if cmp < 0 {
+		n.izq = e.guardarRec(n.izq, clave, dato)
+	} else {
+		n.der = e.guardarRec(n.der, clave, dato)
+	}
+	return e.balancear(n)
+}
+
+func (e *escrituraMVCC[K, V]) Borrar(clave K) V {
+	e.verificarVigente()
+	var borrado V
+	var ok bool
+	e.raiz, borrado, ok = e.borrarRec(e.raiz, clave)
+	if !ok {
+		panic("La clave no pertenece al diccionario")
+	}
+	e.cantidad--
+	return borrado
+}
+
+// borrarRec sólo copia los nodos del camino si la clave pertenece
+func (e *escrituraMVCC[K, V]) borrarRec(n *nodoMVCC[K, V], clave K) (*nodoMVCC[K, V], V, bool) {
+	if n == nil {
+		var cero V
+		return nil, cero, false
+	}
+	cmp := e.cmp(clave, n.clave)
+	if cmp != 0 {
+		hijo := n.izq
+		if cmp > 0 {
+			hijo = n.der
+		}
+		nuevoHijo, borrado, ok := e.borrarRec(hijo, clave)
+		if !ok {
+			return n, borrado, false
+		}
+		n = e.copiar(n)
+		if cmp < 0 {
+			n.izq = nuevoHijo
+		} else {
+			n.der = nuevoHijo
+		}
+		return e.balancear(n), borrado, true
+	}
+
+	borrado := n.dato
+	if n.izq == nil {
+		return n.der, borrado, true
+	}
+	if n.der == nil {
+		return n.izq, borrado, true
+	}
+	der, sucesor := e.sacarMinimo(n.der)
+	n = e.copiar(n)
+	n.clave, n.dato, n.der = sucesor.clave, sucesor.dato, der
+	return e.balancear(n), borrado, true
+}
+
+// sacarMinimo quita el mínimo del subárbol y devuelve el subárbol resultante junto con el nodo quitado
+func (e *escrituraMVCC[K, V]) sacarMinimo(n *nodoMVCC[K, V]) (*nodoMVCC[K, V], *nodoMVCC[K, V]) {
+	if n.izq == nil {
+		return n.der, n
+	}
+	izq, minimo := e.sacarMinimo(n.izq)
+	n = e.copiar(n)
+	n.izq = izq
+	return e.balancear(n), minimo
+}
@@ -0,0 +1,165 @@
+package diccionario_test
+
+import (
+	"cmp"
+	"sync"
+	TDADiccionario "tdas/diccionario"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func clavesLectura(lectura TDADiccionario.Lectura[int, int]) []int {
+	resultado := []int{}
+	lectura.Iterar(func(clave int, _ int) bool {
+		resultado = append(resultado, clave)
+		return true
+	})
+	return resultado
+}
+
+func TestMVCCVersiones(t *testing.T) {
+	t.Log("Cada actualización publica una versión nueva y las lecturas fijadas no ven los cambios posteriores")
+	dic := TDADiccionario.CrearMVCC[int, int](cmp.Compare, 1)
+	require.EqualValues(t, 0, dic.VersionActual())
+	vacia := dic.Leer()
+	require.EqualValues(t, 0, vacia.Cantidad())
+
+	version := dic.Actualizar(func(d TDADiccionario.DiccionarioOrdenado[int, int]) {
+		for i := 0; i < 10; i++ {
+			d.Guardar(i, i)
+		}
+	})
+	require.EqualValues(t, 1, version)
+	primera := dic.Leer()
+
+	require.EqualValues(t, 2, dic.Actualizar(func(d TDADiccionario.DiccionarioOrdenado[int, int]) {
+		d.Borrar(0)
+		d.Guardar(5, 50)
+		d.Guardar(10, 10)
+		require.EqualValues(t, 10, d.Cantidad())
+	}))
+	segunda := dic.Leer()
+
+	require.EqualValues(t, 0, vacia.Cantidad())
+	require.EqualValues(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, clavesLectura(primera))
+	require.EqualValues(t, 5, primera.Obtener(5))
+	require.EqualValues(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, clavesLectura(segunda))
+	require.EqualValues(t, 50, segunda.Obtener(5))
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { segunda.Obtener(0) })
+	require.EqualValues(t, 2, segunda.Version())
+}
+
+func TestMVCCIteradorRangoConsistente(t *testing.T) {
+	t.Log("Un iterador de rango sobre una versión fijada no se ve afectado por actualizaciones intercaladas")
+	dic := TDADiccionario.CrearMVCC[int, int](cmp.Compare, 1)
+	dic.Actualizar(func(d TDADiccionario.DiccionarioOrdenado[int, int]) {
+		for i := 0; i < 100; i++ {
+			d.Guardar(i, i)
+		}
+	})
+	lectura := dic.Leer()
+	desde, hasta := 20, 40
+	iter := lectura.IteradorRango(&desde, &hasta)
+	encontradas := 0
+	for iter.HaySiguiente() {
+		clave, dato := iter.VerActual()
+		require.EqualValues(t, desde+encontradas, clave)
+		require.EqualValues(t, clave, dato)
+		dic.Actualizar(func(d TDADiccionario.DiccionarioOrdenado[int, int]) {
+			d.Borrar(clave)
+			d.Guardar(clave+1000, 0)
+		})
+		encontradas++
+		iter.Siguiente()
+	}
+	require.EqualValues(t, 21, encontradas)
+	lectura.Liberar()
+	require.PanicsWithValue(t, "La lectura ya fue liberada", func() { iter.HaySiguiente() })
+	require.PanicsWithValue(t, "La lectura ya fue liberada", func() { lectura.Liberar() })
+}
+
+func TestMVCCDescarte(t *testing.T) {
+	t.Log("Las versiones viejas se descartan al salir de la retención, salvo mientras estén fijadas")
+	dic := TDADiccionario.CrearMVCC[int, int](cmp.Compare, 2)
+	guardar := func(clave int) uint64 {
+		return dic.Actualizar(func(d TDADiccionario.DiccionarioOrdenado[int, int]) { d.Guardar(clave, clave) })
+	}
+	guardar(1)
+	fijada, err := dic.LeerVersion(1)
+	require.NoError(t, err)
+	guardar(2)
+	guardar(3)
+	guardar(4)
+
+	_, err = dic.LeerVersion(2)
+	require.ErrorIs(t, err, TDADiccionario.ErrVersionNoDisponible)
+	_, err = dic.LeerVersion(5)
+	require.ErrorIs(t, err, TDADiccionario.ErrVersionNoDisponible)
+	retenida, err := dic.LeerVersion(3)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, retenida.Cantidad())
+	retenida.Liberar()
+
+	otra, err := dic.LeerVersion(1)
+	require.NoError(t, err)
+	fijada.Liberar()
+	require.EqualValues(t, []int{1}, clavesLectura(otra))
+	otra.Liberar()
+	_, err = dic.LeerVersion(1)
+	require.ErrorIs(t, err, TDADiccionario.ErrVersionNoDisponible)
+}
+
+func TestMVCCActualizacionFallida(t *testing.T) {
+	t.Log("Si la actualización entra en pánico no se publica ninguno de sus cambios")
+	dic := TDADiccionario.CrearMVCC[int, int](cmp.Compare, 1)
+	dic.Actualizar(func(d TDADiccionario.DiccionarioOrdenado[int, int]) { d.Guardar(1, 1) })
+	var escapada TDADiccionario.DiccionarioOrdenado[int, int]
+	require.Panics(t, func() {
+		dic.Actualizar(func(d TDADiccionario.DiccionarioOrdenado[int, int]) {
+			escapada = d
+			d.Guardar(2, 2)
+			d.Borrar(3)
+		})
+	})
+	require.EqualValues(t, 1, dic.VersionActual())
+	require.EqualValues(t, []int{1}, clavesLectura(dic.Leer()))
+	require.PanicsWithValue(t, "La actualizacion ya termino", func() { escapada.Guardar(4, 4) })
+}
+
+func TestMVCCConcurrente(t *testing.T) {
+	t.Log("Los lectores recorren versiones consistentes mientras un escritor actualiza")
+	dic := TDADiccionario.CrearMVCC[int, int](cmp.Compare, 1)
+	var lectores sync.WaitGroup
+	for l := 0; l < 4; l++ {
+		lectores.Add(1)
+		go func() {
+			defer lectores.Done()
+			for i := 0; i < 200; i++ {
+				lectura := dic.Leer()
+				// En la versión v están exactamente las claves 0..v-1, cada una con dato igual a v-1
+				version := int(lectura.Version())
+				cantidad := 0
+				lectura.Iterar(func(clave int, dato int) bool {
+					if clave != cantidad || dato != version-1 {
+						panic("version inconsistente")
+					}
+					cantidad++
+					return true
+				})
+				if cantidad != version || lectura.Cantidad() != version {
+					panic("cantidad inconsistente")
+				}
+				lectura.Liberar()
+			}
+		}()
+	}
+	for v := 1; v <= 300; v++ {
+		dic.Actualizar(func(d TDADiccionario.DiccionarioOrdenado[int, int]) {
+			for clave := 0; clave < v; clave++ {
+				d.Guardar(clave, v-1)
+			}
+		})
+	}
+	lectores.Wait()
+}
@@ -0,0 +1,150 @@
+package diccionario
+
+// TipoEvento indica qué cambio produjo un Evento
+type TipoEvento int
+
+const (
+	// Insertado indica que se guardó una clave que no pertenecía al diccionario
+	Insertado TipoEvento = iota
+
+	// Actualizado indica que se guardó un dato nuevo para una clave que ya pertenecía
+	Actualizado
+
+	// Borrado indica que se borró una clave
+	Borrado
+)
+
+// Evento describe un cambio en un DiccionarioObservable. Anterior sólo tiene sentido en Actualizado y Borrado,
+// y Nuevo en Insertado y Actualizado; en los demás casos tienen el valor cero de V
+type Evento[K comparable, V any] struct {
+	Tipo     TipoEvento
+	Clave    K
+	Anterior V
+	Nuevo    V
+}
+
+// DiccionarioObservable es un DiccionarioOrdenado que avisa de cada cambio a sus suscriptores.
+//
+// Los eventos se emiten sincrónicamente, en la misma goroutine que modificó el diccionario, recién después de
+// que la primitiva terminó de aplicar todos sus cambios: los suscriptores siempre ven al diccionario en un
+// estado consistente, que ya incluye el cambio notificado (y, en las operaciones masivas, todos los cambios de
+// la operación). Los suscriptores pueden consultar el diccionario, pero no deben modificarlo.
+type DiccionarioObservable[K comparable, V any] interface {
+	DiccionarioOrdenado[K, V]
+
+	// Suscribir registra una función que recibirá todos los eventos, en el orden en que se registró respecto de
+	// los demás suscriptores. Devuelve una función que cancela la suscripción
+	Suscribir(oyente func(Evento[K, V])) (cancelar func())
+
+	// SuscribirCanal envía todos los eventos al canal. El envío es bloqueante: si nadie lee del canal y éste no
+	// tiene lugar, la primitiva que produjo el evento no termina. Devuelve una función que cancela la
+	// suscripción, sin cerrar el canal
+	SuscribirCanal(canal chan<- Evento[K, V]) (cancelar func())
+
+	// GuardarTodos guarda todos los pares de origen, emitiendo un evento por cada uno en el orden en que los
+	// recorre origen.Iterar
+	GuardarTodos(origen Diccionario[K, V])
+
+	// BorrarRango borra todas las claves comprendidas en el rango (con los mismos límites que IterarRango),
+	// emitiendo un evento Borrado por cada una en orden, y devuelve la cantidad de claves borradas
+	BorrarRango(desde *K, hasta *K) int
+}
+
+type suscripcion[K comparable, V any] struct {
+	oyente    func(Evento[K, V])
+	cancelada bool
+}
+
+type diccionarioObservable[K comparable, V any] struct {
+	DiccionarioOrdenado[K, V]
+
+	// suscripciones se reemplaza entera al suscribir o cancelar, para que cancelar durante una emisión no
+	// altere la lista que se está recorriendo. Como una emisión masiva recorre esa lista con varios eventos,
+	// además se marca la suscripción cancelada para no entregarle los que faltan
+	suscripciones []*suscripcion[K, V]
+}
+
+// CrearObservable envuelve a dic para emitir eventos ante sus cambios. A partir de este momento dic sólo debe
+// modificarse a través del diccionario devuelto, o los cambios no se notificarán
+func CrearObservable[K comparable, V any](dic DiccionarioOrdenado[K, V]) DiccionarioObservable[K, V] {
+	return &diccionarioObservable[K, V]{DiccionarioOrdenado: dic}
+}
+
+func (d *diccionarioObservable[K, V]) Suscribir(oyente func(Evento[K, V])) func() {
+	nueva := &suscripcion[K, V]{oyente: oyente}
+	d.suscripciones = append(d.suscripciones[:len(d.suscripciones):len(d.suscripciones)], nueva)
+	return func() {
+		nueva.cancelada = true
+		for i, s := range d.suscripciones {
+			if s == nueva {
+				d.suscripciones = append(d.suscripciones[:i:i], d.suscripciones[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+func (d *diccionarioObservable[K, V]) SuscribirCanal(canal chan<- Evento[K, V]) func() {
+	return d.Suscribir(func(evento Evento[K, V]) { canal <- evento })
+}
+
+func (d *diccionarioObservable[K, V]) emitir(eventos ...Evento[K, V]) {
+	suscripciones := d.suscripciones
+	for _, evento := range eventos {
+		for _, s := range suscripciones {
+			if !s.cancelada {
+				s.oyente(evento)
+			}
+		}
+	}
+}
+
+// guardar aplica el guardado y devuelve el evento correspondiente sin emitirlo
+func (d *diccionarioObservable[K, V]) guardar(clave K, dato V) Evento[K, V] {
+	evento := Evento[K, V]{Tipo: Insertado, Clave: clave, Nuevo: dato}
+	if d.DiccionarioOrdenado.Pertenece(clave) {
+		evento.Tipo = Actualizado
+		evento.Anterior = d.DiccionarioOrdenado.Obtener(clave)
+	}
+	d.DiccionarioOrdenado.Guardar(clave, dato)
+	return evento
+}
+
+func (d *diccionarioObservable[K, V]) Guardar(clave K, dato V) {
+	d.emitir(d.guardar(clave, dato))
+}
+
+func (d *diccionarioObservable[K, V]) Borrar(clave K) V {
+	borrado := d.DiccionarioOrdenado.Borrar(clave)
+	d.emitir(Evento[K, V]{Tipo: Borrado, Clave: clave, Anterior: borrado})
+	return borrado
+}
+
+func (d *diccionarioObservable[K, V]) GuardarTodos(origen Diccionario[K, V]) {
+	var claves []K
+	var datos []V
+	origen.Iterar(func(clave K, dato V) bool {
+		claves = append(claves, clave)
+		datos = append(datos, dato)
+		return true
+	})
+	eventos := make([]Evento[K, V], len(claves))
+	for i := range claves {
+		eventos[i] = d.guardar(claves[i], datos[i])
+	}
+	d.emitir(eventos...)
+}
+
+func (d *diccionarioObservable[K, V]) BorrarRango(desde *K, hasta *K) int {
+	var claves []K
+	d.DiccionarioOrdenado.IterarRango(desde, hasta, func(clave K, _ V) bool {
+		claves = append(claves, clave)
+		return true
+	})
+	eventos := make([]Evento[K, V], len(claves))
+	for i, clave := range claves {
+		eventos[i] = Evento[K, V]{Tipo: Borrado, Clave: clave, Anterior: d.DiccionarioOrdenado.Borrar(clave)}
+	}
+	d.emitir(eventos...)
+	return len(claves)
+}
@@ -0,0 +1,120 @@
+package diccionario_test
+
+import (
+	"cmp"
+	TDADiccionario "tdas/diccionario"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func crearObservable() TDADiccionario.DiccionarioObservable[int, string] {
+	return TDADiccionario.CrearObservable[int, string](TDADiccionario.CrearABB[int, string](cmp.Compare))
+}
+
+func TestObservableEventos(t *testing.T) {
+	t.Log("Guardar y Borrar emiten eventos de inserción, actualización y borrado con los datos correspondientes")
+	dic := crearObservable()
+	var eventos []TDADiccionario.Evento[int, string]
+	dic.Suscribir(func(evento TDADiccionario.Evento[int, string]) { eventos = append(eventos, evento) })
+
+	dic.Guardar(1, "a")
+	dic.Guardar(1, "b")
+	require.EqualValues(t, "b", dic.Borrar(1))
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar(1) })
+
+	require.EqualValues(t, []TDADiccionario.Evento[int, string]{
+		{Tipo: TDADiccionario.Insertado, Clave: 1, Nuevo: "a"},
+		{Tipo: TDADiccionario.Actualizado, Clave: 1, Anterior: "a", Nuevo: "b"},
+		{Tipo: TDADiccionario.Borrado, Clave: 1, Anterior: "b"},
+	}, eventos)
+}
+
+func TestObservableConsistencia(t *testing.T) {
+	t.Log("Los suscriptores ven al diccionario con el cambio ya aplicado")
+	dic := crearObservable()
+	dic.Suscribir(func(evento TDADiccionario.Evento[int, string]) {
+		require.EqualValues(t, evento.Tipo != TDADiccionario.Borrado, dic.Pertenece(evento.Clave))
+		if evento.Tipo != TDADiccionario.Borrado {
+			require.EqualValues(t, evento.Nuevo, dic.Obtener(evento.Clave))
+		}
+	})
+	dic.Guardar(1, "a")
+	dic.Guardar(1, "b")
+	dic.Borrar(1)
+}
+
+func TestObservableMasivas(t *testing.T) {
+	t.Log("Las operaciones masivas emiten un evento por clave después de aplicar todos los cambios")
+	dic := crearObservable()
+	dic.Guardar(2, "viejo")
+	origen := TDADiccionario.CrearABB[int, string](cmp.Compare)
+	for i := 1; i <= 5; i++ {
+		origen.Guardar(i, "nuevo")
+	}
+
+	var eventos []TDADiccionario.Evento[int, string]
+	cancelarCantidad := dic.Suscribir(func(evento TDADiccionario.Evento[int, string]) {
+		require.EqualValues(t, 5, dic.Cantidad())
+		eventos = append(eventos, evento)
+	})
+	dic.GuardarTodos(origen)
+	cancelarCantidad()
+	require.Len(t, eventos, 5)
+	require.EqualValues(t, TDADiccionario.Actualizado, eventos[1].Tipo)
+	require.EqualValues(t, "viejo", eventos[1].Anterior)
+
+	canal := make(chan TDADiccionario.Evento[int, string], 10)
+	cancelar := dic.SuscribirCanal(canal)
+	desde, hasta := 2, 4
+	require.EqualValues(t, 3, dic.BorrarRango(&desde, &hasta))
+	close(canal)
+	claves := []int{}
+	for evento := range canal {
+		require.EqualValues(t, TDADiccionario.Borrado, evento.Tipo)
+		claves = append(claves, evento.Clave)
+	}
+	require.EqualValues(t, []int{2, 3, 4}, claves)
+	cancelar()
+}
+
+func TestObservableCancelar(t *testing.T) {
+	t.Log("Cancelar una suscripción, incluso mientras se emite un evento, deja de notificarla")
+	dic := crearObservable()
+	recibidos := 0
+	var cancelar func()
+	cancelar = dic.Suscribir(func(TDADiccionario.Evento[int, string]) {
+		recibidos++
+		cancelar()
+	})
+	otros := 0
+	dic.Suscribir(func(TDADiccionario.Evento[int, string]) { otros++ })
+
+	dic.Guardar(1, "a")
+	dic.Guardar(2, "b")
+	require.EqualValues(t, 1, recibidos)
+	require.EqualValues(t, 2, otros)
+
+	// En una operación masiva, quien cancela no recibe el resto de los eventos de la misma operación
+	recibidos = 0
+	cancelar = dic.Suscribir(func(TDADiccionario.Evento[int, string]) {
+		recibidos++
+		cancelar()
+	})
+	origen := TDADiccionario.CrearABB[int, string](cmp.Compare)
+	for i := 10; i < 15; i++ {
+		origen.Guardar(i, "x")
+	}
+	dic.GuardarTodos(origen)
+	require.EqualValues(t, 1, recibidos)
+	require.EqualValues(t, 7, otros)
+
+	recibidos = 0
+	cancelar = dic.Suscribir(func(TDADiccionario.Evento[int, string]) {
+		recibidos++
+		cancelar()
+	})
+	require.EqualValues(t, 7, dic.BorrarRango(nil, nil))
+	require.EqualValues(t, 1, recibidos)
+	require.EqualValues(t, 14, otros)
+}
@@ -0,0 +1,75 @@
+package diccionario
+
+import "strings"
+
+// limiteSuperiorPrefijo devuelve la menor cadena mayor a todas las que empiezan con el prefijo, en orden de
+// bytes: el prefijo sin los 0xFF finales y con su último byte incrementado. Si el prefijo es vacío o son todos
+// 0xFF no hay tal cadena, y devuelve false
+func limiteSuperiorPrefijo(prefijo string) (string, bool) {
+	limite := []byte(prefijo)
+	for i := len(limite) - 1; i >= 0; i-- {
+		if limite[i] != 0xFF {
+			limite[i]++
+			return string(limite[:i+1]), true
+		}
+	}
+	return "", false
+}
+
+// rangoPrefijo devuelve los límites con los que iterar un diccionario para encontrar las claves con el prefijo.
+// El límite superior es el primero que no tiene el prefijo, por lo que quien itere debe descartarlo
+func rangoPrefijo(prefijo string) (*string, *string) {
+	desde := prefijo
+	if limite, ok := limiteSuperiorPrefijo(prefijo); ok {
+		return &desde, &limite
+	}
+	return &desde, nil
+}
+
+// IterarPrefijo visita en orden las claves del diccionario que empiezan con el prefijo. El diccionario debe
+// estar ordenado por bytes, como con strings.Compare (que para UTF-8 válido coincide con el orden de los code
+// points), y la iteración termina en cuanto aparece una clave que no empieza con el prefijo
+func IterarPrefijo[V any](dic DiccionarioOrdenado[string, V], prefijo string, visitar func(clave string, dato V) bool) {
+	desde, hasta := rangoPrefijo(prefijo)
+	dic.IterarRango(desde, hasta, func(clave string, dato V) bool {
+		if !strings.HasPrefix(clave, prefijo) {
+			return false
+		}
+		return visitar(clave, dato)
+	})
+}
+
+// iteradorPrefijo termina en cuanto el iterador de rango llega a una clave sin el prefijo
+type iteradorPrefijo[V any] struct {
+	iter    IterDiccionario[string, V]
+	prefijo string
+}
+
+// IteradorPrefijo devuelve un IterDiccionario sobre las claves del diccionario que empiezan con el prefijo, con
+// las mismas condiciones que IterarPrefijo
+func IteradorPrefijo[V any](dic DiccionarioOrdenado[string, V], prefijo string) IterDiccionario[string, V] {
+	desde, hasta := rangoPrefijo(prefijo)
+	return &iteradorPrefijo[V]{iter: dic.IteradorRango(desde, hasta), prefijo: prefijo}
+}
+
+func (iter *iteradorPrefijo[V]) HaySiguiente() bool {
+	if !iter.iter.HaySiguiente() {
+		return false
+	}
+	clave, _ := iter.iter.VerActual()
+	return strings.HasPrefix(clave, iter.prefijo)
+}
+
+func (iter *iteradorPrefijo[V]) VerActual() (string, V) {
+	if !iter.HaySiguiente() {
+		panic("El iterador termino de iterar")
+	}
+	return iter.iter.VerActual()
+}
+
+func (iter *iteradorPrefijo[V]) Siguiente() {
+	if !iter.HaySiguiente() {
+		panic("El iterador termino de iterar")
+	}
+	iter.iter.Siguiente()
+}
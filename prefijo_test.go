@@ -0,0 +1,89 @@
+package diccionario_test
+
+import (
+	"strings"
+	TDADiccionario "tdas/diccionario"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func clavesConPrefijo(dic TDADiccionario.DiccionarioOrdenado[string, int], prefijo string) ([]string, []string) {
+	internas := []string{}
+	TDADiccionario.IterarPrefijo(dic, prefijo, func(clave string, _ int) bool {
+		internas = append(internas, clave)
+		return true
+	})
+	externas := []string{}
+	for iter := TDADiccionario.IteradorPrefijo(dic, prefijo); iter.HaySiguiente(); iter.Siguiente() {
+		clave, _ := iter.VerActual()
+		externas = append(externas, clave)
+	}
+	return internas, externas
+}
+
+func TestPrefijoAutocompletar(t *testing.T) {
+	t.Log("Encuentra las claves con un prefijo, sin incluir a la cota superior ni a las claves vecinas")
+	dic := TDADiccionario.CrearABB[string, int](strings.Compare)
+	for i, clave := range []string{"ca", "cas", "casa", "casamiento", "casb", "cat", "c", "b", "casa\xff", "casz"} {
+		dic.Guardar(clave, i)
+	}
+
+	internas, externas := clavesConPrefijo(dic, "cas")
+	require.EqualValues(t, []string{"cas", "casa", "casamiento", "casa\xff", "casb", "casz"}, internas)
+	require.EqualValues(t, internas, externas)
+
+	internas, externas = clavesConPrefijo(dic, "casa")
+	require.EqualValues(t, []string{"casa", "casamiento", "casa\xff"}, internas)
+	require.EqualValues(t, internas, externas)
+
+	internas, externas = clavesConPrefijo(dic, "d")
+	require.Empty(t, internas)
+	require.Empty(t, externas)
+
+	internas, _ = clavesConPrefijo(dic, "")
+	require.Len(t, internas, dic.Cantidad())
+
+	iter := TDADiccionario.IteradorPrefijo[int](dic, "cat")
+	clave, dato := iter.VerActual()
+	require.EqualValues(t, "cat", clave)
+	require.EqualValues(t, 5, dato)
+	iter.Siguiente()
+	require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.VerActual() })
+}
+
+func TestPrefijoBytesMaximos(t *testing.T) {
+	t.Log("Prefijos terminados en 0xFF o formados sólo por 0xFF no tienen cota superior simple")
+	dic := TDADiccionario.CrearABB[string, int](strings.Compare)
+	for i, clave := range []string{"a", "a\xff", "a\xff\xff", "a\xff\x01", "b", "\xff", "\xff\xff", "\xfe\xff"} {
+		dic.Guardar(clave, i)
+	}
+
+	internas, externas := clavesConPrefijo(dic, "a\xff")
+	require.EqualValues(t, []string{"a\xff", "a\xff\x01", "a\xff\xff"}, internas)
+	require.EqualValues(t, internas, externas)
+
+	internas, externas = clavesConPrefijo(dic, "\xff")
+	require.EqualValues(t, []string{"\xff", "\xff\xff"}, internas)
+	require.EqualValues(t, internas, externas)
+
+	internas, _ = clavesConPrefijo(dic, "\xfe")
+	require.EqualValues(t, []string{"\xfe\xff"}, internas)
+}
+
+func TestPrefijoUnicode(t *testing.T) {
+	t.Log("Los prefijos con caracteres de varios bytes respetan el orden de los code points")
+	dic := TDADiccionario.CrearSkipList[string, int](strings.Compare)
+	for i, clave := range []string{"niño", "niña", "ninja", "nio", "ñandú", "ñu", "日本", "日本語", "日曜"} {
+		dic.Guardar(clave, i)
+	}
+	internas, externas := clavesConPrefijo(dic, "niñ")
+	require.EqualValues(t, []string{"niña", "niño"}, internas)
+	require.EqualValues(t, internas, externas)
+
+	internas, _ = clavesConPrefijo(dic, "ñ")
+	require.EqualValues(t, []string{"ñandú", "ñu"}, internas)
+
+	internas, _ = clavesConPrefijo(dic, "日本")
+	require.EqualValues(t, []string{"日本", "日本語"}, internas)
+}
@@ -0,0 +1,158 @@
+package diccionario
+
+import "math"
+
+// scapegoat es un ABB que se mantiene balanceado sin guardar información adicional en los nodos: usa los mismos
+// nodoABB que el abb, y cuando una inserción deja un nodo demasiado profundo reconstruye el subárbol de un
+// ancestro desbalanceado (el chivo expiatorio). Las búsquedas e iteraciones se delegan al abb, que no se embebe
+// para que ninguna de sus primitivas de modificación evite el rebalanceo
+type scapegoat[K comparable, V any] struct {
+	arbol       abb[K, V]
+	alfa        float64
+	maxCantidad int
+}
+
+// CrearScapegoat crea un scapegoat tree con el factor de balance alfa, que debe estar en el intervalo (0.5, 1).
+// Con alfa cercano a 0.5 el árbol se mantiene más balanceado a costa de reconstruir más seguido; con alfa
+// cercano a 1 se reconstruye menos pero las búsquedas pueden ser más largas
+func CrearScapegoat[K comparable, V any](cmp func(K, K) int, alfa float64) DiccionarioOrdenado[K, V] {
+	if alfa <= 0.5 || alfa >= 1 {
+		panic("El factor de balance debe estar entre 0.5 y 1")
+	}
+	return &scapegoat[K, V]{
+		arbol: abb[K, V]{raiz: nil, cantidad: 0, cmp: cmp},
+		alfa:  alfa,
+	}
+}
+
+// profundidadMaxima es la profundidad a partir de la cual un nodo indica que el árbol dejó de estar
+// alfa-balanceado
+func (s *scapegoat[K, V]) profundidadMaxima() int {
+	return int(math.Floor(math.Log(float64(s.arbol.cantidad)) / math.Log(1/s.alfa)))
+}
+
+func (s *scapegoat[K, V]) Guardar(clave K, dato V) {
+	// camino guarda los ancestros del nodo nuevo, desde la raíz
+	var camino []*nodoABB[K, V]
+	actual := &s.arbol.raiz
+	for *actual != nil {
+		cmp := s.arbol.cmp(clave, (*actual).clave)
+		if cmp == 0 {
+			(*actual).dato = dato
+			return
+		}
+		camino = append(camino, *actual)
+		if cmp < 0 {
+			actual = &(*actual).izq
+		} else {
+			actual = &(*actual).der
+		}
+	}
+	nuevo := &nodoABB[K, V]{clave: clave, dato: dato}
+	*actual = nuevo
+	s.arbol.cantidad++
+	s.maxCantidad = max(s.maxCantidad, s.arbol.cantidad)
+
+	if len(camino) > s.profundidadMaxima() {
+		s.reconstruirChivoExpiatorio(camino, nuevo)
+	}
+}
+
+// reconstruirChivoExpiatorio sube desde el nodo insertado hasta encontrar el primer ancestro con un hijo que
+// tenga más de alfa veces su cantidad de nodos, y reconstruye balanceado el subárbol de ese ancestro
+func (s *scapegoat[K, V]) reconstruirChivoExpiatorio(camino []*nodoABB[K, V], hijo *nodoABB[K, V]) {
+	tamHijo := 1
+	for i := len(camino) - 1; i >= 0; i-- {
+		padre := camino[i]
+		hermano := padre.izq
+		if hermano == hijo {
+			hermano = padre.der
+		}
+		tamPadre := tamHijo + contarNodos(hermano) + 1
+		if float64(tamHijo) > s.alfa*float64(tamPadre) {
+			reconstruido := reconstruirBalanceado(padre, tamPadre)
+			if i == 0 {
+				s.arbol.raiz = reconstruido
+			} else if abuelo := camino[i-1]; abuelo.izq == padre {
+				abuelo.izq = reconstruido
+			} else {
+				abuelo.der = reconstruido
+			}
+			return
+		}
+		hijo = padre
+		tamHijo = tamPadre
+	}
+}
+
+func (s *scapegoat[K, V]) Borrar(clave K) V {
+	borrado := s.arbol.Borrar(clave)
+	if float64(s.arbol.cantidad) < s.alfa*float64(s.maxCantidad) {
+		s.arbol.raiz = reconstruirBalanceado(s.arbol.raiz, s.arbol.cantidad)
+		s.maxCantidad = s.arbol.cantidad
+	}
+	return borrado
+}
+
+func (s *scapegoat[K, V]) Pertenece(clave K) bool {
+	return s.arbol.Pertenece(clave)
+}
+
+func (s *scapegoat[K, V]) Obtener(clave K) V {
+	return s.arbol.Obtener(clave)
+}
+
+func (s *scapegoat[K, V]) Cantidad() int {
+	return s.arbol.Cantidad()
+}
+
+func (s *scapegoat[K, V]) Iterar(visitar func(K, V) bool) {
+	s.arbol.Iterar(visitar)
+}
+
+func (s *scapegoat[K, V]) IterarRango(desde *K, hasta *K, visitar func(K, V) bool) {
+	s.arbol.IterarRango(desde, hasta, visitar)
+}
+
+func (s *scapegoat[K, V]) Iterador() IterDiccionario[K, V] {
+	return s.arbol.Iterador()
+}
+
+func (s *scapegoat[K, V]) IteradorRango(desde *K, hasta *K) IterDiccionario[K, V] {
+	return s.arbol.IteradorRango(desde, hasta)
+}
+
+func contarNodos[K comparable, V any](n *nodoABB[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return 1 + contarNodos(n.izq) + contarNodos(n.der)
+}
+
+// reconstruirBalanceado arma un ABB perfectamente balanceado con los mismos nodos del subárbol, que tiene la
+// cantidad de nodos indicada
+func reconstruirBalanceado[K comparable, V any](n *nodoABB[K, V], cantidad int) *nodoABB[K, V] {
+	nodos := make([]*nodoABB[K, V], 0, cantidad)
+	var aplanar func(*nodoABB[K, V])
+	aplanar = func(n *nodoABB[K, V]) {
+		if n == nil {
+			return
+		}
+		aplanar(n.izq)
+		nodos = append(nodos, n)
+		aplanar(n.der)
+	}
+	aplanar(n)
+	return armarBalanceado(nodos)
+}
+
+func armarBalanceado[K comparable, V any](nodos []*nodoABB[K, V]) *nodoABB[K, V] {
+	if len(nodos) == 0 {
+		return nil
+	}
+	medio := len(nodos) / 2
+	raiz := nodos[medio]
+	raiz.izq = armarBalanceado(nodos[:medio])
+	raiz.der = armarBalanceado(nodos[medio+1:])
+	return raiz
+}
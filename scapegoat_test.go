@@ -0,0 +1,60 @@
+package diccionario_test
+
+import (
+	"cmp"
+	"strings"
+	TDADiccionario "tdas/diccionario"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScapegoatVacio(t *testing.T) {
+	t.Log("Comprueba que un scapegoat tree vacío no tiene claves")
+	dic := TDADiccionario.CrearScapegoat[string, string](strings.Compare, 0.7)
+	require.EqualValues(t, 0, dic.Cantidad())
+	require.False(t, dic.Pertenece("A"))
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Obtener("A") })
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar("A") })
+	require.False(t, dic.Iterador().HaySiguiente())
+}
+
+func TestScapegoatAlfaInvalido(t *testing.T) {
+	t.Log("El factor de balance debe estar estrictamente entre 0.5 y 1")
+	for _, alfa := range []float64{0.5, 1, 0.2, 1.5} {
+		require.PanicsWithValue(t, "El factor de balance debe estar entre 0.5 y 1", func() {
+			TDADiccionario.CrearScapegoat[int, int](cmp.Compare, alfa)
+		})
+	}
+}
+
+func TestScapegoatContrato(t *testing.T) {
+	t.Log("Una secuencia aleatoria de operaciones se comporta igual que un map ordenado")
+	for _, alfa := range []float64{0.55, 0.75, 0.95} {
+		verificarContratoOrdenado(t, TDADiccionario.CrearScapegoat[int, int](cmp.Compare, alfa), 17)
+	}
+}
+
+func TestScapegoatInsercionOrdenada(t *testing.T) {
+	t.Log("Insertar en orden, que en un ABB común genera una lista, se mantiene en tiempo razonable")
+	dic := TDADiccionario.CrearScapegoat[int, int](cmp.Compare, 0.6)
+	for i := 0; i < 100000; i++ {
+		dic.Guardar(i, i)
+	}
+	for i := 0; i < 100000; i += 2 {
+		dic.Borrar(i)
+	}
+	require.EqualValues(t, 50000, dic.Cantidad())
+	require.EqualValues(t, 99999, dic.Obtener(99999))
+}
+
+func TestScapegoatNoExponePrimitivasDelABB(t *testing.T) {
+	t.Log("El scapegoat no expone las primitivas del abb que lo modificarían sin rebalancear")
+	var dic any = TDADiccionario.CrearScapegoat[int, int](cmp.Compare, 0.6)
+	_, ok := dic.(interface{ SacarMinimo() (int, int) })
+	require.False(t, ok)
+	_, ok = dic.(interface{ IntentarSacarMaximo() (int, int, bool) })
+	require.False(t, ok)
+	_, ok = dic.(TDADiccionario.Visualizable[int, int])
+	require.True(t, ok)
+}
@@ -0,0 +1,183 @@
+package diccionario
+
+import (
+	"math/rand"
+	"time"
+)
+
+// nivelMaximoSkipList alcanza para 2^32 elementos con probabilidad 1/2 de subir de nivel
+const nivelMaximoSkipList = 32
+
+// nodoSkipList tiene un puntero al siguiente nodo por cada nivel en el que aparece. El nivel 0 enlaza a todos
+// los nodos en orden
+type nodoSkipList[K comparable, V any] struct {
+	clave      K
+	dato       V
+	siguientes []*nodoSkipList[K, V]
+}
+
+type skipList[K comparable, V any] struct {
+	cabecera  *nodoSkipList[K, V]
+	nivel     int
+	cantidad  int
+	cmp       func(K, K) int
+	aleatorio *rand.Rand
+}
+
+// CrearSkipList crea un diccionario ordenado implementado con una skip list, cuyas operaciones tienen costo
+// esperado O(log n)
+func CrearSkipList[K comparable, V any](cmp func(K, K) int) DiccionarioOrdenado[K, V] {
+	return CrearSkipListConSemilla[K, V](cmp, time.Now().UnixNano())
+}
+
+// CrearSkipListConSemilla crea una skip list cuyos niveles se sortean a partir de la semilla indicada, de
+// forma que la misma secuencia de operaciones produce siempre la misma estructura
+func CrearSkipListConSemilla[K comparable, V any](cmp func(K, K) int, semilla int64) DiccionarioOrdenado[K, V] {
+	return &skipList[K, V]{
+		cabecera:  &nodoSkipList[K, V]{siguientes: make([]*nodoSkipList[K, V], nivelMaximoSkipList)},
+		nivel:     1,
+		cantidad:  0,
+		cmp:       cmp,
+		aleatorio: rand.New(rand.NewSource(semilla)),
+	}
+}
+
+// sortearNivel devuelve un nivel entre 1 y nivelMaximoSkipList, donde cada nivel tiene la mitad de
+// probabilidad que el anterior
+func (s *skipList[K, V]) sortearNivel() int {
+	nivel := 1
+	for nivel < nivelMaximoSkipList && s.aleatorio.Int63()&1 == 1 {
+		nivel++
+	}
+	return nivel
+}
+
+// buscarAnteriores completa, para cada nivel, el último nodo con clave menor a la indicada, y devuelve el nodo
+// siguiente a él en el nivel 0 (que es el de la clave, si pertenece)
+func (s *skipList[K, V]) buscarAnteriores(clave K, anteriores []*nodoSkipList[K, V]) *nodoSkipList[K, V] {
+	actual := s.cabecera
+	for nivel := s.nivel - 1; nivel >= 0; nivel-- {
+		for actual.siguientes[nivel] != nil && s.cmp(actual.siguientes[nivel].clave, clave) < 0 {
+			actual = actual.siguientes[nivel]
+		}
+		if anteriores != nil {
+			anteriores[nivel] = actual
+		}
+	}
+	return actual.siguientes[0]
+}
+
+func (s *skipList[K, V]) buscarNodo(clave K) *nodoSkipList[K, V] {
+	nodo := s.buscarAnteriores(clave, nil)
+	if nodo == nil || s.cmp(nodo.clave, clave) != 0 {
+		return nil
+	}
+	return nodo
+}
+
+func (s *skipList[K, V]) Guardar(clave K, dato V) {
+	anteriores := make([]*nodoSkipList[K, V], nivelMaximoSkipList)
+	nodo := s.buscarAnteriores(clave, anteriores)
+	if nodo != nil && s.cmp(nodo.clave, clave) == 0 {
+		nodo.dato = dato
+		return
+	}
+
+	nivel := s.sortearNivel()
+	for ; s.nivel < nivel; s.nivel++ {
+		anteriores[s.nivel] = s.cabecera
+	}
+	nuevo := &nodoSkipList[K, V]{clave: clave, dato: dato, siguientes: make([]*nodoSkipList[K, V], nivel)}
+	for i := 0; i < nivel; i++ {
+		nuevo.siguientes[i] = anteriores[i].siguientes[i]
+		anteriores[i].siguientes[i] = nuevo
+	}
+	s.cantidad++
+}
+
+func (s *skipList[K, V]) Pertenece(clave K) bool {
+	return s.buscarNodo(clave) != nil
+}
+
+func (s *skipList[K, V]) Obtener(clave K) V {
+	nodo := s.buscarNodo(clave)
+	if nodo == nil {
+		panic("La clave no pertenece al diccionario")
+	}
+	return nodo.dato
+}
+
+func (s *skipList[K, V]) Borrar(clave K) V {
+	anteriores := make([]*nodoSkipList[K, V], nivelMaximoSkipList)
+	nodo := s.buscarAnteriores(clave, anteriores)
+	if nodo == nil || s.cmp(nodo.clave, clave) != 0 {
+		panic("La clave no pertenece al diccionario")
+	}
+	for i := range nodo.siguientes {
+		anteriores[i].siguientes[i] = nodo.siguientes[i]
+	}
+	for s.nivel > 1 && s.cabecera.siguientes[s.nivel-1] == nil {
+		s.nivel--
+	}
+	s.cantidad--
+	return nodo.dato
+}
+
+func (s *skipList[K, V]) Cantidad() int {
+	return s.cantidad
+}
+
+// primeroDesde devuelve el primer nodo con clave mayor o igual a desde, o el primero de todos si desde es nil
+func (s *skipList[K, V]) primeroDesde(desde *K) *nodoSkipList[K, V] {
+	if desde == nil {
+		return s.cabecera.siguientes[0]
+	}
+	return s.buscarAnteriores(*desde, nil)
+}
+
+func (s *skipList[K, V]) Iterar(visitar func(K, V) bool) {
+	s.IterarRango(nil, nil, visitar)
+}
+
+func (s *skipList[K, V]) IterarRango(desde *K, hasta *K, visitar func(K, V) bool) {
+	for nodo := s.primeroDesde(desde); nodo != nil; nodo = nodo.siguientes[0] {
+		if hasta != nil && s.cmp(nodo.clave, *hasta) > 0 {
+			return
+		}
+		if !visitar(nodo.clave, nodo.dato) {
+			return
+		}
+	}
+}
+
+type iteradorSkipList[K comparable, V any] struct {
+	actual *nodoSkipList[K, V]
+	cmp    func(K, K) int
+	hasta  *K
+}
+
+func (s *skipList[K, V]) Iterador() IterDiccionario[K, V] {
+	return s.IteradorRango(nil, nil)
+}
+
+func (s *skipList[K, V]) IteradorRango(desde *K, hasta *K) IterDiccionario[K, V] {
+	return &iteradorSkipList[K, V]{actual: s.primeroDesde(desde), cmp: s.cmp, hasta: hasta}
+}
+
+func (iter *iteradorSkipList[K, V]) HaySiguiente() bool {
+	return iter.actual != nil && (iter.hasta == nil || iter.cmp(iter.actual.clave, *iter.hasta) <= 0)
+}
+
+func (iter *iteradorSkipList[K, V]) VerActual() (K, V) {
+	if !iter.HaySiguiente() {
+		panic("El iterador termino de iterar")
+	}
+	return iter.actual.clave, iter.actual.dato
+}
+
+func (iter *iteradorSkipList[K, V]) Siguiente() {
+	if !iter.HaySiguiente() {
+		panic("El iterador termino de iterar")
+	}
+	iter.actual = iter.actual.siguientes[0]
+}
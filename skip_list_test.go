@@ -0,0 +1,53 @@
+package diccionario_test
+
+import (
+	"cmp"
+	"math/rand"
+	"strings"
+	TDADiccionario "tdas/diccionario"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSkipListVacia(t *testing.T) {
+	t.Log("Comprueba que una skip list vacía no tiene claves")
+	dic := TDADiccionario.CrearSkipList[string, string](strings.Compare)
+	require.EqualValues(t, 0, dic.Cantidad())
+	require.False(t, dic.Pertenece("A"))
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Obtener("A") })
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar("A") })
+	iter := dic.Iterador()
+	require.False(t, iter.HaySiguiente())
+	require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.VerActual() })
+}
+
+func TestSkipListContrato(t *testing.T) {
+	t.Log("Una secuencia aleatoria de operaciones se comporta igual que un map ordenado")
+	verificarContratoOrdenado(t, TDADiccionario.CrearSkipListConSemilla[int, int](cmp.Compare, 7), 7)
+}
+
+// comparacionesSkipList devuelve los pares que compara una skip list con la semilla indicada al guardar y
+// buscar las mismas claves. Como los recorridos dependen de los niveles de cada nodo, la secuencia de pares
+// identifica la estructura
+func comparacionesSkipList(semilla int64) [][2]int {
+	var pares [][2]int
+	registrar := func(a, b int) int {
+		pares = append(pares, [2]int{a, b})
+		return cmp.Compare(a, b)
+	}
+	dic := TDADiccionario.CrearSkipListConSemilla[int, int](registrar, semilla)
+	for _, clave := range rand.New(rand.NewSource(1)).Perm(200) {
+		dic.Guardar(clave, clave)
+	}
+	for clave := 0; clave < 200; clave++ {
+		dic.Pertenece(clave)
+	}
+	return pares
+}
+
+func TestSkipListSemilla(t *testing.T) {
+	t.Log("La misma semilla produce la misma estructura, y otra semilla una distinta")
+	require.EqualValues(t, comparacionesSkipList(3), comparacionesSkipList(3))
+	require.NotEqualValues(t, comparacionesSkipList(3), comparacionesSkipList(4))
+}
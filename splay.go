@@ -0,0 +1,262 @@
+package diccionario
+
+// splay es un ABB autoajustable: toda operación que accede a una clave la lleva a la raíz mediante rotaciones,
+// por lo que las claves accedidas recientemente quedan cerca de la raíz. Las operaciones tienen costo
+// amortizado O(log n), aunque una operación individual puede ser O(n)
+type splay[K comparable, V any] struct {
+	raiz     *nodoABB[K, V]
+	cantidad int
+	cmp      func(K, K) int
+	metricas *Metricas
+}
+
+// CrearSplay crea un diccionario ordenado implementado con un splay tree, que lleva a la raíz las claves a las
+// que se accede.
+//
+// A diferencia del resto de los diccionarios, Pertenece, Obtener y los iteradores también modifican la
+// estructura del árbol. Por eso no es seguro acceder desde varias goroutines a la vez aunque todas sólo lean:
+// cualquier acceso concurrente, incluidas las lecturas, requiere un lock exclusivo (sync.Mutex, no la parte de
+// lectura de un sync.RWMutex).
+func CrearSplay[K comparable, V any](cmp func(K, K) int, opciones ...OpcionABB) DiccionarioOrdenado[K, V] {
+	config, cmpEnvuelto := aplicarOpciones(cmp, opciones)
+	return &splay[K, V]{
+		raiz:     nil,
+		cantidad: 0,
+		cmp:      cmpEnvuelto,
+		metricas: config.metricas,
+	}
+}
+
+// splayear lleva a la raíz del subárbol al nodo con la clave indicada, o al último nodo visitado buscándola
+// (su predecesor o sucesor) si no pertenece. Implementa el splay top-down de Sleator y Tarjan
+func (s *splay[K, V]) splayear(n *nodoABB[K, V], clave K) *nodoABB[K, V] {
+	if n == nil {
+		return nil
+	}
+	// En cabecera.der se arma el árbol de las claves menores y en cabecera.izq el de las mayores
+	var cabecera nodoABB[K, V]
+	maxMenores, minMayores := &cabecera, &cabecera
+	for {
+		cmp := s.cmp(clave, n.clave)
+		if cmp < 0 {
+			if n.izq == nil {
+				break
+			}
+			if s.cmp(clave, n.izq.clave) < 0 {
+				n = rotarDerecha(n)
+				s.contarRotacion()
+				if n.izq == nil {
+					break
+				}
+			}
+			minMayores.izq = n
+			minMayores = n
+			n = n.izq
+		} else if cmp > 0 {
+			if n.der == nil {
+				break
+			}
+			if s.cmp(clave, n.der.clave) > 0 {
+				n = rotarIzquierda(n)
+				s.contarRotacion()
+				if n.der == nil {
+					break
+				}
+			}
+			maxMenores.der = n
+			maxMenores = n
+			n = n.der
+		} else {
+			break
+		}
+	}
+	maxMenores.der = n.izq
+	minMayores.izq = n.der
+	n.izq = cabecera.der
+	n.der = cabecera.izq
+	return n
+}
+
+func (s *splay[K, V]) contarRotacion() {
+	if s.metricas != nil {
+		s.metricas.Rotaciones.Add(1)
+	}
+}
+
+func rotarDerecha[K comparable, V any](n *nodoABB[K, V]) *nodoABB[K, V] {
+	hijo := n.izq
+	n.izq = hijo.der
+	hijo.der = n
+	return hijo
+}
+
+func rotarIzquierda[K comparable, V any](n *nodoABB[K, V]) *nodoABB[K, V] {
+	hijo := n.der
+	n.der = hijo.izq
+	hijo.izq = n
+	return hijo
+}
+
+// acceder lleva la clave a la raíz e indica si pertenece
+func (s *splay[K, V]) acceder(clave K) bool {
+	s.raiz = s.splayear(s.raiz, clave)
+	return s.raiz != nil && s.cmp(s.raiz.clave, clave) == 0
+}
+
+func (s *splay[K, V]) Guardar(clave K, dato V) {
+	if s.acceder(clave) {
+		s.raiz.dato = dato
+		return
+	}
+	nuevo := &nodoABB[K, V]{clave: clave, dato: dato}
+	if s.metricas != nil {
+		s.metricas.NodosCreados.Add(1)
+	}
+	if s.raiz != nil {
+		if s.cmp(clave, s.raiz.clave) < 0 {
+			nuevo.izq = s.raiz.izq
+			nuevo.der = s.raiz
+			s.raiz.izq = nil
+		} else {
+			nuevo.der = s.raiz.der
+			nuevo.izq = s.raiz
+			s.raiz.der = nil
+		}
+	}
+	s.raiz = nuevo
+	s.cantidad++
+}
+
+func (s *splay[K, V]) Pertenece(clave K) bool {
+	return s.acceder(clave)
+}
+
+func (s *splay[K, V]) Obtener(clave K) V {
+	if !s.acceder(clave) {
+		panic("La clave no pertenece al diccionario")
+	}
+	return s.raiz.dato
+}
+
+func (s *splay[K, V]) Borrar(clave K) V {
+	if !s.acceder(clave) {
+		panic("La clave no pertenece al diccionario")
+	}
+	borrado := s.raiz.dato
+	if s.raiz.izq == nil {
+		s.raiz = s.raiz.der
+	} else {
+		// Al splayear la clave en el subárbol izquierdo, su máximo queda como raíz y sin hijo derecho
+		der := s.raiz.der
+		s.raiz = s.splayear(s.raiz.izq, clave)
+		s.raiz.der = der
+	}
+	s.cantidad--
+	return borrado
+}
+
+func (s *splay[K, V]) Cantidad() int {
+	return s.cantidad
+}
+
+// primeroDesde lleva a la raíz y devuelve el nodo con la menor clave mayor o igual a desde (o la menor de todas
+// si desde es nil), o nil si no hay
+func (s *splay[K, V]) primeroDesde(desde *K) *nodoABB[K, V] {
+	if s.raiz == nil {
+		return nil
+	}
+	if desde == nil {
+		minimo := s.raiz
+		for minimo.izq != nil {
+			minimo = minimo.izq
+		}
+		s.raiz = s.splayear(s.raiz, minimo.clave)
+		return s.raiz
+	}
+	s.raiz = s.splayear(s.raiz, *desde)
+	if s.cmp(s.raiz.clave, *desde) >= 0 {
+		return s.raiz
+	}
+	return s.siguienteA(*desde)
+}
+
+// siguienteA lleva a la raíz y devuelve el nodo con la menor clave estrictamente mayor a la indicada, o nil si
+// no hay. Llevar cada nodo visitado a la raíz hace que recorrer todo el árbol en orden cueste O(n)
+func (s *splay[K, V]) siguienteA(clave K) *nodoABB[K, V] {
+	if s.raiz == nil {
+		return nil
+	}
+	s.raiz = s.splayear(s.raiz, clave)
+	if s.cmp(s.raiz.clave, clave) > 0 {
+		return s.raiz
+	}
+	if s.raiz.der == nil {
+		return nil
+	}
+	sucesor := s.raiz.der
+	for sucesor.izq != nil {
+		sucesor = sucesor.izq
+	}
+	s.raiz = s.splayear(s.raiz, sucesor.clave)
+	return s.raiz
+}
+
+func (s *splay[K, V]) Iterar(visitar func(K, V) bool) {
+	s.IterarRango(nil, nil, visitar)
+}
+
+// IterarRango recorre el árbol buscando el sucesor de cada clave desde la raíz, en lugar de hacerlo
+// recursivamente, para que las rotaciones que produzcan las operaciones realizadas dentro de visitar no
+// afecten a la iteración
+func (s *splay[K, V]) IterarRango(desde *K, hasta *K, visitar func(K, V) bool) {
+	iter := s.iteradorRango(desde, hasta)
+	for iter.HaySiguiente() {
+		clave, dato := iter.VerActual()
+		if !visitar(clave, dato) {
+			return
+		}
+		iter.Siguiente()
+	}
+}
+
+// iteradorSplay sólo recuerda el nodo actual y busca el siguiente por clave al avanzar, por lo que no se ve
+// afectado por las rotaciones que producen las lecturas entre un paso y otro
+type iteradorSplay[K comparable, V any] struct {
+	arbol  *splay[K, V]
+	actual *nodoABB[K, V]
+	hasta  *K
+}
+
+func (s *splay[K, V]) Iterador() IterDiccionario[K, V] {
+	return s.IteradorRango(nil, nil)
+}
+
+func (s *splay[K, V]) IteradorRango(desde *K, hasta *K) IterDiccionario[K, V] {
+	if s.metricas != nil {
+		s.metricas.Iteradores.Add(1)
+	}
+	return s.iteradorRango(desde, hasta)
+}
+
+// iteradorRango crea el iterador sin contarlo en las métricas, para usarlo en la iteración interna
+func (s *splay[K, V]) iteradorRango(desde *K, hasta *K) *iteradorSplay[K, V] {
+	return &iteradorSplay[K, V]{arbol: s, actual: s.primeroDesde(desde), hasta: hasta}
+}
+
+func (iter *iteradorSplay[K, V]) HaySiguiente() bool {
+	return iter.actual != nil && (iter.hasta == nil || iter.arbol.cmp(iter.actual.clave, *iter.hasta) <= 0)
+}
+
+func (iter *iteradorSplay[K, V]) VerActual() (K, V) {
+	if !iter.HaySiguiente() {
+		panic("El iterador termino de iterar")
+	}
+	return iter.actual.clave, iter.actual.dato
+}
+
+func (iter *iteradorSplay[K, V]) Siguiente() {
+	if !iter.HaySiguiente() {
+		panic("El iterador termino de iterar")
+	}
+	iter.actual = iter.arbol.siguienteA(iter.actual.clave)
+}
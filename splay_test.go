@@ -0,0 +1,89 @@
+package diccionario_test
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+	TDADiccionario "tdas/diccionario"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplayVacio(t *testing.T) {
+	t.Log("Comprueba que un splay tree vacío no tiene claves")
+	dic := TDADiccionario.CrearSplay[string, string](strings.Compare)
+	require.EqualValues(t, 0, dic.Cantidad())
+	require.False(t, dic.Pertenece("A"))
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Obtener("A") })
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar("A") })
+	require.False(t, dic.Iterador().HaySiguiente())
+}
+
+func TestSplayContrato(t *testing.T) {
+	t.Log("Una secuencia aleatoria de operaciones se comporta igual que un map ordenado")
+	verificarContratoOrdenado(t, TDADiccionario.CrearSplay[int, int](cmp.Compare), 13)
+}
+
+func TestSplayLecturasDuranteIteracion(t *testing.T) {
+	t.Log("Las lecturas realizadas mientras se itera reestructuran el árbol sin afectar a los iteradores")
+	dic := TDADiccionario.CrearSplay[int, int](cmp.Compare)
+	for i := 0; i < 100; i++ {
+		dic.Guardar(i, i)
+	}
+
+	visitadas := []int{}
+	dic.Iterar(func(clave int, dato int) bool {
+		require.EqualValues(t, clave, dato)
+		require.EqualValues(t, 99-clave, dic.Obtener(99-clave))
+		require.True(t, dic.Pertenece(clave/2))
+		visitadas = append(visitadas, clave)
+		return true
+	})
+	require.Len(t, visitadas, 100)
+	for i, clave := range visitadas {
+		require.EqualValues(t, i, clave)
+	}
+
+	iter1 := dic.Iterador()
+	iter2 := dic.Iterador()
+	for i := 0; i < 100; i++ {
+		clave1, _ := iter1.VerActual()
+		require.EqualValues(t, i, clave1)
+		iter1.Siguiente()
+		dic.Obtener(100 - i - 1)
+		clave2, _ := iter2.VerActual()
+		require.EqualValues(t, i, clave2)
+		iter2.Siguiente()
+	}
+	require.False(t, iter1.HaySiguiente())
+	require.False(t, iter2.HaySiguiente())
+}
+
+// raizSplay devuelve la clave de la raíz, que es la única línea del dibujo ASCII sin ramas
+func raizSplay(t *testing.T, dic TDADiccionario.DiccionarioOrdenado[int, int]) string {
+	var b strings.Builder
+	require.NoError(t, dic.(TDADiccionario.Visualizable[int, int]).ExportarASCII(&b, formatearClave))
+	for _, linea := range strings.Split(b.String(), "\n") {
+		if linea != "" && !strings.ContainsAny(linea, "│┌└ ") {
+			return linea
+		}
+	}
+	return ""
+}
+
+func TestSplayAccesoLlevaALaRaiz(t *testing.T) {
+	t.Log("Guardar, Obtener y Pertenece llevan la clave accedida a la raíz")
+	dic := TDADiccionario.CrearSplay[int, int](cmp.Compare)
+	for i := 0; i < 50; i++ {
+		dic.Guardar(i, i)
+		require.EqualValues(t, fmt.Sprint(i), raizSplay(t, dic))
+	}
+	dic.Obtener(7)
+	require.EqualValues(t, "7", raizSplay(t, dic))
+	require.True(t, dic.Pertenece(31))
+	require.EqualValues(t, "31", raizSplay(t, dic))
+	dic.Guardar(12, 0)
+	require.EqualValues(t, "12", raizSplay(t, dic))
+	require.EqualValues(t, 50, dic.Cantidad())
+}
@@ -0,0 +1,236 @@
+package diccionario
+
+// Transaccion es una vista modificable de un diccionario ordenado cuyos cambios quedan pendientes hasta
+// Confirmar, que los aplica todos juntos, o Deshacer, que los descarta sin tocar el diccionario original. Las
+// primitivas de la transacción ven el diccionario original junto con sus propios cambios pendientes.
+//
+// Mientras la transacción está abierta, el diccionario original no debe modificarse por fuera de ella. Una vez
+// confirmada o deshecha, cualquier primitiva de la transacción entra en pánico con un mensaje
+// 'La transaccion ya termino'
+type Transaccion[K comparable, V any] interface {
+	DiccionarioOrdenado[K, V]
+
+	// Confirmar aplica al diccionario original todos los cambios pendientes. No puede fallar a mitad de camino: las claves borradas que ya no pertenezcan al original simplemente se ignoran
+	Confirmar()
+
+	// Deshacer descarta todos los cambios pendientes
+	Deshacer()
+}
+
+// cambioTransaccion es la última operación pendiente sobre una clave: un borrado o un guardado con su dato
+type cambioTransaccion[V any] struct {
+	borrado bool
+	dato    V
+}
+
+// transaccion guarda los cambios pendientes en un avl propio, ordenado con la misma función que el original,
+// para poder recorrer ambos a la vez en orden al iterar. No se usa un abb porque el abb crea transacciones, y
+// un abb de cambios crearía transacciones de cambios de cambios sin fin al instanciar los tipos genéricos
+type transaccion[K comparable, V any] struct {
+	original  DiccionarioOrdenado[K, V]
+	cambios   *avl[K, cambioTransaccion[V], struct{}]
+	cantidad  int
+	terminada bool
+}
+
+func crearTransaccion[K comparable, V any](original DiccionarioOrdenado[K, V], cmp func(K, K) int) Transaccion[K, V] {
+	return &transaccion[K, V]{
+		original: original,
+		cambios:  &avl[K, cambioTransaccion[V], struct{}]{cmp: cmp},
+		cantidad: original.Cantidad(),
+	}
+}
+
+func (a *abb[K, V]) Transaccion() Transaccion[K, V] {
+	return crearTransaccion[K, V](a, a.cmp)
+}
+
+// Transaccion confirma a través del scapegoat, y no de su abb interno, para mantenerlo balanceado
+func (s *scapegoat[K, V]) Transaccion() Transaccion[K, V] {
+	return crearTransaccion[K, V](s, s.arbol.cmp)
+}
+
+func (t *transaccion[K, V]) verificarAbierta() {
+	if t.terminada {
+		panic("La transaccion ya termino")
+	}
+}
+
+// buscar devuelve el dato de la clave en la vista de la transacción, y si pertenece
+func (t *transaccion[K, V]) buscar(clave K) (V, bool) {
+	if nodo := t.cambios.buscarNodo(clave); nodo != nil {
+		return nodo.dato.dato, !nodo.dato.borrado
+	}
+	if t.original.Pertenece(clave) {
+		return t.original.Obtener(clave), true
+	}
+	var cero V
+	return cero, false
+}
+
+func (t *transaccion[K, V]) Guardar(clave K, dato V) {
+	t.verificarAbierta()
+	if _, pertenece := t.buscar(clave); !pertenece {
+		t.cantidad++
+	}
+	t.cambios.Guardar(clave, cambioTransaccion[V]{dato: dato})
+}
+
+func (t *transaccion[K, V]) Pertenece(clave K) bool {
+	t.verificarAbierta()
+	_, pertenece := t.buscar(clave)
+	return pertenece
+}
+
+func (t *transaccion[K, V]) Obtener(clave K) V {
+	t.verificarAbierta()
+	dato, pertenece := t.buscar(clave)
+	if !pertenece {
+		panic("La clave no pertenece al diccionario")
+	}
+	return dato
+}
+
+func (t *transaccion[K, V]) Borrar(clave K) V {
+	t.verificarAbierta()
+	dato, pertenece := t.buscar(clave)
+	if !pertenece {
+		panic("La clave no pertenece al diccionario")
+	}
+	t.cantidad--
+	t.cambios.Guardar(clave, cambioTransaccion[V]{borrado: true})
+	return dato
+}
+
+func (t *transaccion[K, V]) Cantidad() int {
+	t.verificarAbierta()
+	return t.cantidad
+}
+
+func (t *transaccion[K, V]) Confirmar() {
+	t.verificarAbierta()
+	t.terminada = true
+	claves := make([]K, 0, t.cambios.Cantidad())
+	cambios := make([]cambioTransaccion[V], 0, t.cambios.Cantidad())
+	t.cambios.Iterar(func(clave K, cambio cambioTransaccion[V]) bool {
+		claves = append(claves, clave)
+		cambios = append(cambios, cambio)
+		return true
+	})
+	t.aplicarDesdeElMedio(claves, cambios)
+}
+
+// aplicarDesdeElMedio aplica primero el cambio de la clave del medio y luego los de cada mitad. Aplicarlos en
+// orden de claves convertiría al abb original en una lista si la transacción agrega muchas claves nuevas
+func (t *transaccion[K, V]) aplicarDesdeElMedio(claves []K, cambios []cambioTransaccion[V]) {
+	if len(claves) == 0 {
+		return
+	}
+	medio := len(claves) / 2
+	if clave, cambio := claves[medio], cambios[medio]; !cambio.borrado {
+		t.original.Guardar(clave, cambio.dato)
+	} else if t.original.Pertenece(clave) {
+		t.original.Borrar(clave)
+	}
+	t.aplicarDesdeElMedio(claves[:medio], cambios[:medio])
+	t.aplicarDesdeElMedio(claves[medio+1:], cambios[medio+1:])
+}
+
+func (t *transaccion[K, V]) Deshacer() {
+	t.verificarAbierta()
+	t.terminada = true
+}
+
+func (t *transaccion[K, V]) Iterar(visitar func(K, V) bool) {
+	t.IterarRango(nil, nil, visitar)
+}
+
+func (t *transaccion[K, V]) IterarRango(desde *K, hasta *K, visitar func(K, V) bool) {
+	for iter := t.IteradorRango(desde, hasta); iter.HaySiguiente(); iter.Siguiente() {
+		if !visitar(iter.VerActual()) {
+			return
+		}
+	}
+}
+
+// iteradorTransaccion mezcla en orden los iteradores del original y de los cambios pendientes. Ante una misma
+// clave en ambos prevalece el cambio, y las claves borradas se saltean
+type iteradorTransaccion[K comparable, V any] struct {
+	transaccion *transaccion[K, V]
+	original    IterDiccionario[K, V]
+	cambios     IterDiccionario[K, cambioTransaccion[V]]
+	clave       K
+	dato        V
+	hayActual   bool
+}
+
+func (t *transaccion[K, V]) Iterador() IterDiccionario[K, V] {
+	return t.IteradorRango(nil, nil)
+}
+
+func (t *transaccion[K, V]) IteradorRango(desde *K, hasta *K) IterDiccionario[K, V] {
+	t.verificarAbierta()
+	iter := &iteradorTransaccion[K, V]{
+		transaccion: t,
+		original:    t.original.IteradorRango(desde, hasta),
+		cambios:     t.cambios.IteradorRango(desde, hasta),
+	}
+	iter.avanzar()
+	return iter
+}
+
+// avanzar deja en clave y dato el menor elemento visible que queda en alguno de los dos iteradores
+func (iter *iteradorTransaccion[K, V]) avanzar() {
+	for {
+		hayOriginal, hayCambio := iter.original.HaySiguiente(), iter.cambios.HaySiguiente()
+		if !hayOriginal && !hayCambio {
+			iter.hayActual = false
+			return
+		}
+		if !hayCambio {
+			iter.clave, iter.dato = iter.original.VerActual()
+			iter.original.Siguiente()
+			iter.hayActual = true
+			return
+		}
+		claveCambio, cambio := iter.cambios.VerActual()
+		if hayOriginal {
+			claveOriginal, datoOriginal := iter.original.VerActual()
+			comparacion := iter.transaccion.cambios.cmp(claveCambio, claveOriginal)
+			if comparacion > 0 {
+				iter.clave, iter.dato = claveOriginal, datoOriginal
+				iter.original.Siguiente()
+				iter.hayActual = true
+				return
+			}
+			if comparacion == 0 {
+				iter.original.Siguiente()
+			}
+		}
+		iter.cambios.Siguiente()
+		if !cambio.borrado {
+			iter.clave, iter.dato = claveCambio, cambio.dato
+			iter.hayActual = true
+			return
+		}
+	}
+}
+
+func (iter *iteradorTransaccion[K, V]) HaySiguiente() bool {
+	iter.transaccion.verificarAbierta()
+	return iter.hayActual
+}
+
+func (iter *iteradorTransaccion[K, V]) VerActual() (K, V) {
+	if !iter.HaySiguiente() {
+		panic("El iterador termino de iterar")
+	}
+	return iter.clave, iter.dato
+}
+
+func (iter *iteradorTransaccion[K, V]) Siguiente() {
+	if !iter.HaySiguiente() {
+		panic("El iterador termino de iterar")
+	}
+	iter.avanzar()
+}
@@ -0,0 +1,127 @@
+package diccionario_test
+
+import (
+	"cmp"
+	TDADiccionario "tdas/diccionario"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func clavesEnOrden(dic TDADiccionario.DiccionarioOrdenado[int, string]) []int {
+	resultado := []int{}
+	dic.Iterar(func(clave int, _ string) bool {
+		resultado = append(resultado, clave)
+		return true
+	})
+	return resultado
+}
+
+func crearConTransaccion() (TDADiccionario.ABB[int, string], TDADiccionario.Transaccion[int, string]) {
+	dic := TDADiccionario.CrearABB[int, string](cmp.Compare)
+	for _, clave := range []int{1, 3, 5, 7} {
+		dic.Guardar(clave, "original")
+	}
+	return dic, dic.Transaccion()
+}
+
+func TestTransaccionLeeSusEscrituras(t *testing.T) {
+	t.Log("La transacción ve sus propios cambios mientras el original queda intacto")
+	dic, tx := crearConTransaccion()
+	tx.Guardar(2, "nuevo")
+	tx.Guardar(3, "cambiado")
+	require.EqualValues(t, "original", tx.Borrar(5))
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { tx.Borrar(5) })
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { tx.Obtener(5) })
+
+	require.EqualValues(t, 4, tx.Cantidad())
+	require.EqualValues(t, "cambiado", tx.Obtener(3))
+	require.False(t, tx.Pertenece(5))
+	require.EqualValues(t, []int{1, 2, 3, 7}, clavesEnOrden(tx))
+
+	require.EqualValues(t, 4, dic.Cantidad())
+	require.EqualValues(t, "original", dic.Obtener(3))
+	require.EqualValues(t, []int{1, 3, 5, 7}, clavesEnOrden(dic))
+}
+
+func TestTransaccionConfirmar(t *testing.T) {
+	t.Log("Confirmar aplica todos los cambios y cierra la transacción")
+	dic, tx := crearConTransaccion()
+	tx.Guardar(9, "nuevo")
+	tx.Borrar(1)
+	tx.Guardar(1, "reinsertado")
+	tx.Borrar(7)
+	tx.Confirmar()
+
+	require.EqualValues(t, []int{1, 3, 5, 9}, clavesEnOrden(dic))
+	require.EqualValues(t, "reinsertado", dic.Obtener(1))
+	require.NoError(t, dic.Validar())
+	require.PanicsWithValue(t, "La transaccion ya termino", func() { tx.Guardar(2, "x") })
+	require.PanicsWithValue(t, "La transaccion ya termino", func() { tx.Confirmar() })
+}
+
+func TestTransaccionDeshacer(t *testing.T) {
+	t.Log("Deshacer descarta los cambios sin modificar el original")
+	dic, tx := crearConTransaccion()
+	tx.Guardar(2, "nuevo")
+	tx.Borrar(3)
+	iter := tx.Iterador()
+	tx.Deshacer()
+
+	require.EqualValues(t, []int{1, 3, 5, 7}, clavesEnOrden(dic))
+	require.PanicsWithValue(t, "La transaccion ya termino", func() { tx.Cantidad() })
+	require.PanicsWithValue(t, "La transaccion ya termino", func() { iter.HaySiguiente() })
+}
+
+func TestTransaccionIteradorRango(t *testing.T) {
+	t.Log("El iterador de rango mezcla el original con los cambios respetando los límites")
+	_, tx := crearConTransaccion()
+	tx.Guardar(4, "nuevo")
+	tx.Guardar(0, "fuera")
+	tx.Borrar(3)
+	tx.Guardar(5, "cambiado")
+	desde, hasta := 2, 6
+	iter := tx.IteradorRango(&desde, &hasta)
+	encontrados := map[int]string{}
+	orden := []int{}
+	for iter.HaySiguiente() {
+		clave, dato := iter.VerActual()
+		encontrados[clave] = dato
+		orden = append(orden, clave)
+		iter.Siguiente()
+	}
+	require.EqualValues(t, []int{4, 5}, orden)
+	require.EqualValues(t, "cambiado", encontrados[5])
+	require.PanicsWithValue(t, "El iterador termino de iterar", func() { iter.Siguiente() })
+}
+
+func TestTransaccionConfirmarMantieneAltura(t *testing.T) {
+	t.Log("Confirmar muchas claves nuevas no convierte al abb en una lista")
+	dic := TDADiccionario.CrearABB[int, string](cmp.Compare)
+	tx := dic.Transaccion()
+	for i := 0; i < 2000; i++ {
+		tx.Guardar(i, "x")
+	}
+	tx.Confirmar()
+	require.EqualValues(t, 2000, dic.Cantidad())
+	require.LessOrEqual(t, dic.Estadisticas().Altura, 11)
+	require.NoError(t, dic.Validar())
+}
+
+func TestTransaccionScapegoat(t *testing.T) {
+	t.Log("La transacción de un scapegoat confirma a través del scapegoat")
+	dic := TDADiccionario.CrearScapegoat[int, string](cmp.Compare, 0.6)
+	tx := dic.(interface {
+		Transaccion() TDADiccionario.Transaccion[int, string]
+	}).Transaccion()
+	for i := 0; i < 100; i++ {
+		tx.Guardar(i, "x")
+	}
+	require.EqualValues(t, 0, dic.Cantidad())
+	tx.Confirmar()
+	require.EqualValues(t, 100, dic.Cantidad())
+	altura := dic.(interface {
+		Estadisticas() TDADiccionario.Estadisticas
+	}).Estadisticas().Altura
+	require.LessOrEqual(t, altura, 11)
+}
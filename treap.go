@@ -0,0 +1,294 @@
+package diccionario
+
+import (
+	"math/rand"
+	"time"
+
+	TDAPila "tdas/pila"
+)
+
+// Treap es un diccionario ordenado implementado con un treap, que además permite partirlo y unirlo por clave
+type Treap[K comparable, V any] interface {
+	DiccionarioOrdenado[K, V]
+	Visualizable[K, V]
+
+	// Dividir mueve todos los elementos a dos treaps nuevos: el primero con las claves menores a la indicada y el
+	// segundo con las claves mayores o iguales. El treap original queda vacío
+	Dividir(clave K) (Treap[K, V], Treap[K, V])
+
+	// Fusionar mueve todos los elementos de otro al treap, dejando a otro vacío. Todas las claves de otro deben
+	// ser mayores a las del treap; si no, entra en pánico. Ambos treaps deben usar la misma función de
+	// comparación, lo que no puede verificarse: con funciones distintas el resultado no está definido
+	Fusionar(otro Treap[K, V])
+}
+
+// nodoTreap cumple la propiedad de ABB por clave y la de heap de máximos por prioridad. tamanio es la cantidad de
+// nodos de su subárbol, para que Dividir sepa cuántos elementos quedan de cada lado sin recorrerlos
+type nodoTreap[K comparable, V any] struct {
+	clave     K
+	dato      V
+	prioridad int64
+	tamanio   int
+	izq       *nodoTreap[K, V]
+	der       *nodoTreap[K, V]
+}
+
+func tamanioTreap[K comparable, V any](n *nodoTreap[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.tamanio
+}
+
+// actualizarTamanio recalcula el tamaño del nodo después de cambiarle alguno de sus hijos
+func (n *nodoTreap[K, V]) actualizarTamanio() {
+	n.tamanio = 1 + tamanioTreap(n.izq) + tamanioTreap(n.der)
+}
+
+type treap[K comparable, V any] struct {
+	raiz      *nodoTreap[K, V]
+	cantidad  int
+	cmp       func(K, K) int
+	aleatorio *rand.Rand
+}
+
+// CrearTreap crea un treap vacío, cuyas operaciones tienen costo esperado O(log n)
+func CrearTreap[K comparable, V any](cmp func(K, K) int) Treap[K, V] {
+	return CrearTreapConSemilla[K, V](cmp, time.Now().UnixNano())
+}
+
+// CrearTreapConSemilla crea un treap cuyas prioridades se sortean a partir de la semilla indicada, de forma que
+// la misma secuencia de operaciones produce siempre la misma estructura
+func CrearTreapConSemilla[K comparable, V any](cmp func(K, K) int, semilla int64) Treap[K, V] {
+	return &treap[K, V]{
+		raiz:      nil,
+		cantidad:  0,
+		cmp:       cmp,
+		aleatorio: rand.New(rand.NewSource(semilla)),
+	}
+}
+
+// dividir parte el subárbol en las claves menores a la indicada y las mayores o iguales. Si incluirIgual es true,
+// la clave igual queda con las menores
+func (t *treap[K, V]) dividir(n *nodoTreap[K, V], clave K, incluirIgual bool) (*nodoTreap[K, V], *nodoTreap[K, V]) {
+	if n == nil {
+		return nil, nil
+	}
+	cmp := t.cmp(n.clave, clave)
+	if cmp < 0 || (cmp == 0 && incluirIgual) {
+		menores, mayores := t.dividir(n.der, clave, incluirIgual)
+		n.der = menores
+		n.actualizarTamanio()
+		return n, mayores
+	}
+	menores, mayores := t.dividir(n.izq, clave, incluirIgual)
+	n.izq = mayores
+	n.actualizarTamanio()
+	return menores, n
+}
+
+// fusionar une dos subárboles en los que todas las claves de izq son menores a las de der
+func (t *treap[K, V]) fusionar(izq *nodoTreap[K, V], der *nodoTreap[K, V]) *nodoTreap[K, V] {
+	if izq == nil {
+		return der
+	}
+	if der == nil {
+		return izq
+	}
+	if izq.prioridad > der.prioridad {
+		izq.der = t.fusionar(izq.der, der)
+		izq.actualizarTamanio()
+		return izq
+	}
+	der.izq = t.fusionar(izq, der.izq)
+	der.actualizarTamanio()
+	return der
+}
+
+// separar parte al treap en las claves menores, el nodo con la clave (o nil) y las claves mayores
+func (t *treap[K, V]) separar(clave K) (*nodoTreap[K, V], *nodoTreap[K, V], *nodoTreap[K, V]) {
+	menores, resto := t.dividir(t.raiz, clave, false)
+	igual, mayores := t.dividir(resto, clave, true)
+	return menores, igual, mayores
+}
+
+func (t *treap[K, V]) Guardar(clave K, dato V) {
+	menores, igual, mayores := t.separar(clave)
+	if igual == nil {
+		igual = &nodoTreap[K, V]{clave: clave, prioridad: t.aleatorio.Int63(), tamanio: 1}
+		t.cantidad++
+	}
+	igual.dato = dato
+	t.raiz = t.fusionar(t.fusionar(menores, igual), mayores)
+}
+
+func (t *treap[K, V]) Pertenece(clave K) bool {
+	return t.buscarNodo(clave) != nil
+}
+
+func (t *treap[K, V]) Obtener(clave K) V {
+	nodo := t.buscarNodo(clave)
+	if nodo == nil {
+		panic("La clave no pertenece al diccionario")
+	}
+	return nodo.dato
+}
+
+func (t *treap[K, V]) buscarNodo(clave K) *nodoTreap[K, V] {
+	n := t.raiz
+	for n != nil {
+		cmp := t.cmp(clave, n.clave)
+		if cmp < 0 {
+			n = n.izq
+		} else if cmp > 0 {
+			n = n.der
+		} else {
+			return n
+		}
+	}
+	return nil
+}
+
+func (t *treap[K, V]) Borrar(clave K) V {
+	menores, igual, mayores := t.separar(clave)
+	t.raiz = t.fusionar(menores, mayores)
+	if igual == nil {
+		panic("La clave no pertenece al diccionario")
+	}
+	t.cantidad--
+	return igual.dato
+}
+
+func (t *treap[K, V]) Cantidad() int {
+	return t.cantidad
+}
+
+func (t *treap[K, V]) Dividir(clave K) (Treap[K, V], Treap[K, V]) {
+	menores, mayores := t.dividir(t.raiz, clave, false)
+	// Cada mitad sortea con su propia fuente, para que usar una no altere las prioridades que sortea la otra
+	treapMenores := &treap[K, V]{
+		raiz:      menores,
+		cantidad:  tamanioTreap(menores),
+		cmp:       t.cmp,
+		aleatorio: rand.New(rand.NewSource(t.aleatorio.Int63())),
+	}
+	treapMayores := &treap[K, V]{
+		raiz:      mayores,
+		cantidad:  tamanioTreap(mayores),
+		cmp:       t.cmp,
+		aleatorio: rand.New(rand.NewSource(t.aleatorio.Int63())),
+	}
+	t.raiz = nil
+	t.cantidad = 0
+	return treapMenores, treapMayores
+}
+
+func (t *treap[K, V]) Fusionar(otro Treap[K, V]) {
+	o, ok := otro.(*treap[K, V])
+	if !ok {
+		panic("Solo se puede fusionar con otro treap")
+	}
+	if t == o || o.raiz == nil {
+		return
+	}
+	if t.raiz != nil && t.cmp(t.maximo().clave, o.minimo().clave) >= 0 {
+		panic("Las claves del treap a fusionar deben ser mayores a las del treap")
+	}
+	t.raiz = t.fusionar(t.raiz, o.raiz)
+	t.cantidad += o.cantidad
+	o.raiz = nil
+	o.cantidad = 0
+}
+
+func (t *treap[K, V]) minimo() *nodoTreap[K, V] {
+	n := t.raiz
+	for n.izq != nil {
+		n = n.izq
+	}
+	return n
+}
+
+func (t *treap[K, V]) maximo() *nodoTreap[K, V] {
+	n := t.raiz
+	for n.der != nil {
+		n = n.der
+	}
+	return n
+}
+
+func (t *treap[K, V]) Iterar(visitar func(K, V) bool) {
+	t.iterarRango(t.raiz, nil, nil, visitar)
+}
+
+func (t *treap[K, V]) IterarRango(desde *K, hasta *K, visitar func(K, V) bool) {
+	t.iterarRango(t.raiz, desde, hasta, visitar)
+}
+
+func (t *treap[K, V]) iterarRango(n *nodoTreap[K, V], desde *K, hasta *K, visitar func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	mayorADesde := desde == nil || t.cmp(n.clave, *desde) >= 0
+	menorAHasta := hasta == nil || t.cmp(n.clave, *hasta) <= 0
+	if mayorADesde && !t.iterarRango(n.izq, desde, hasta, visitar) {
+		return false
+	}
+	if mayorADesde && menorAHasta && !visitar(n.clave, n.dato) {
+		return false
+	}
+	if menorAHasta {
+		return t.iterarRango(n.der, desde, hasta, visitar)
+	}
+	return true
+}
+
+type iteradorTreap[K comparable, V any] struct {
+	pila  TDAPila.Pila[*nodoTreap[K, V]]
+	cmp   func(K, K) int
+	desde *K
+	hasta *K
+}
+
+// apilarDesdeHasta apila el camino de hijos izquierdos del nodo, salteando los nodos fuera del rango
+func (iter *iteradorTreap[K, V]) apilarDesdeHasta(n *nodoTreap[K, V]) {
+	for n != nil {
+		if iter.desde != nil && iter.cmp(n.clave, *iter.desde) < 0 {
+			n = n.der
+		} else if iter.hasta != nil && iter.cmp(n.clave, *iter.hasta) > 0 {
+			n = n.izq
+		} else {
+			iter.pila.Apilar(n)
+			n = n.izq
+		}
+	}
+}
+
+func (t *treap[K, V]) Iterador() IterDiccionario[K, V] {
+	return t.IteradorRango(nil, nil)
+}
+
+func (t *treap[K, V]) IteradorRango(desde *K, hasta *K) IterDiccionario[K, V] {
+	iter := &iteradorTreap[K, V]{pila: TDAPila.CrearPilaDinamica[*nodoTreap[K, V]](), cmp: t.cmp, desde: desde, hasta: hasta}
+	iter.apilarDesdeHasta(t.raiz)
+	return iter
+}
+
+func (iter *iteradorTreap[K, V]) HaySiguiente() bool {
+	return !iter.pila.EstaVacia()
+}
+
+func (iter *iteradorTreap[K, V]) VerActual() (K, V) {
+	if !iter.HaySiguiente() {
+		panic("El iterador termino de iterar")
+	}
+	n := iter.pila.VerTope()
+	return n.clave, n.dato
+}
+
+func (iter *iteradorTreap[K, V]) Siguiente() {
+	if !iter.HaySiguiente() {
+		panic("El iterador termino de iterar")
+	}
+	n := iter.pila.Desapilar()
+	iter.apilarDesdeHasta(n.der)
+}
@@ -0,0 +1,110 @@
+package diccionario_test
+
+import (
+	"cmp"
+	"strings"
+	TDADiccionario "tdas/diccionario"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func clavesTreap(dic TDADiccionario.Treap[int, int]) []int {
+	claves := []int{}
+	dic.Iterar(func(clave int, _ int) bool {
+		claves = append(claves, clave)
+		return true
+	})
+	return claves
+}
+
+func TestTreapVacio(t *testing.T) {
+	t.Log("Comprueba que un treap vacío no tiene claves")
+	dic := TDADiccionario.CrearTreap[string, string](strings.Compare)
+	require.EqualValues(t, 0, dic.Cantidad())
+	require.False(t, dic.Pertenece("A"))
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Obtener("A") })
+	require.PanicsWithValue(t, "La clave no pertenece al diccionario", func() { dic.Borrar("A") })
+	require.False(t, dic.Iterador().HaySiguiente())
+}
+
+func TestTreapContrato(t *testing.T) {
+	t.Log("Una secuencia aleatoria de operaciones se comporta igual que un map ordenado")
+	verificarContratoOrdenado(t, TDADiccionario.CrearTreapConSemilla[int, int](cmp.Compare, 11), 11)
+}
+
+func TestTreapDividir(t *testing.T) {
+	t.Log("Dividir reparte las claves en menores y mayores o iguales, dejando vacío al original")
+	dic := TDADiccionario.CrearTreapConSemilla[int, int](cmp.Compare, 1)
+	for i := 0; i < 10; i++ {
+		dic.Guardar(i, i*10)
+	}
+	menores, mayores := dic.Dividir(4)
+	require.EqualValues(t, 0, dic.Cantidad())
+	require.False(t, dic.Iterador().HaySiguiente())
+	require.EqualValues(t, []int{0, 1, 2, 3}, clavesTreap(menores))
+	require.EqualValues(t, 4, menores.Cantidad())
+	require.EqualValues(t, []int{4, 5, 6, 7, 8, 9}, clavesTreap(mayores))
+	require.EqualValues(t, 6, mayores.Cantidad())
+	require.EqualValues(t, 40, mayores.Obtener(4))
+
+	// Las mitades siguen funcionando como treaps independientes
+	menores.Guardar(-1, 0)
+	mayores.Borrar(9)
+	require.EqualValues(t, 5, menores.Cantidad())
+	require.EqualValues(t, 5, mayores.Cantidad())
+
+	vacio, todos := mayores.Dividir(-100)
+	require.EqualValues(t, 0, vacio.Cantidad())
+	require.EqualValues(t, []int{4, 5, 6, 7, 8}, clavesTreap(todos))
+}
+
+func TestTreapDividirYFusionarRepetidamente(t *testing.T) {
+	t.Log("Las cantidades se mantienen correctas al dividir y fusionar muchas veces un treap grande")
+	dic := TDADiccionario.CrearTreapConSemilla[int, int](cmp.Compare, 5)
+	for i := 0; i < 10000; i++ {
+		dic.Guardar(i, i)
+	}
+	for corte := 0; corte <= 10000; corte += 500 {
+		menores, mayores := dic.Dividir(corte)
+		require.EqualValues(t, corte, menores.Cantidad())
+		require.EqualValues(t, 10000-corte, mayores.Cantidad())
+		menores.Guardar(-1, -1)
+		menores.Borrar(-1)
+		menores.Fusionar(mayores)
+		dic = menores
+	}
+	require.EqualValues(t, 10000, dic.Cantidad())
+	require.EqualValues(t, 9999, dic.Obtener(9999))
+}
+
+func TestTreapFusionar(t *testing.T) {
+	t.Log("Fusionar une dos treaps cuyas claves no se solapan y rechaza los que se solapan")
+	izq := TDADiccionario.CrearTreapConSemilla[int, int](cmp.Compare, 1)
+	der := TDADiccionario.CrearTreapConSemilla[int, int](cmp.Compare, 2)
+	for i := 0; i < 50; i++ {
+		izq.Guardar(i, i)
+		der.Guardar(i+50, i+50)
+	}
+	solapado := TDADiccionario.CrearTreap[int, int](cmp.Compare)
+	solapado.Guardar(49, 0)
+	require.PanicsWithValue(t, "Las claves del treap a fusionar deben ser mayores a las del treap",
+		func() { izq.Fusionar(solapado) })
+	require.EqualValues(t, 50, izq.Cantidad())
+	require.EqualValues(t, 1, solapado.Cantidad())
+
+	izq.Fusionar(der)
+	require.EqualValues(t, 100, izq.Cantidad())
+	require.EqualValues(t, 0, der.Cantidad())
+	claves := clavesTreap(izq)
+	require.Len(t, claves, 100)
+	for i, clave := range claves {
+		require.EqualValues(t, i, clave)
+	}
+
+	menores, mayores := izq.Dividir(30)
+	mayores.Borrar(99)
+	menores.Fusionar(mayores)
+	require.EqualValues(t, 99, menores.Cantidad())
+	require.EqualValues(t, 98, menores.Obtener(98))
+}
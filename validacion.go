@@ -0,0 +1,155 @@
+package diccionario
+
+import (
+	"errors"
+	"fmt"
+)
+
+// OpcionABB configura un ABB al crearlo con CrearABB o CrearSplay
+type OpcionABB func(*configuracionABB)
+
+type configuracionABB struct {
+	validarComparador bool
+	metricas          *Metricas
+}
+
+// aplicarOpciones arma la configuración y envuelve a la función de comparación según las opciones elegidas
+func aplicarOpciones[K comparable](cmp func(K, K) int, opciones []OpcionABB) (configuracionABB, func(K, K) int) {
+	var config configuracionABB
+	for _, opcion := range opciones {
+		opcion(&config)
+	}
+	if config.validarComparador {
+		cmp = crearValidadorComparador(cmp)
+	}
+	if config.metricas != nil {
+		cmp = contarComparaciones(cmp, config.metricas)
+	}
+	return config, cmp
+}
+
+// ErrComparadorInconsistente es el error envuelto en el pánico que produce un ABB creado con
+// ConValidacionComparador al detectar una función de comparación inválida
+var ErrComparadorInconsistente = errors.New("funcion de comparacion inconsistente")
+
+// ConValidacionComparador es una opción de depuración que envuelve a la función de comparación para verificar,
+// en cada llamada, que sea antisimétrica (cmp(a, b) y cmp(b, a) con signos opuestos), que devuelva 0 al comparar
+// una clave con sí misma, que siempre devuelva el mismo signo para el mismo par de claves y que sea transitiva
+// entre los pares ya comparados (si a < b y b < c, entonces a < c). Ante una violación entra en pánico con un
+// error que envuelve a ErrComparadorInconsistente. La transitividad sólo puede verificarse en las ternas cuyos
+// tres pares llegaron a compararse, por lo que una violación entre claves que el árbol nunca compara entre sí
+// no se detecta.
+//
+// Cada comparación llama dos veces a la función original, el resultado de cada par comparado queda guardado y
+// cada par nuevo se contrasta con todos los pares de una de sus claves, por lo que sólo debe usarse para
+// depurar.
+func ConValidacionComparador() OpcionABB {
+	return func(config *configuracionABB) {
+		config.validarComparador = true
+	}
+}
+
+func signo(n int) int {
+	if n < 0 {
+		return -1
+	}
+	if n > 0 {
+		return 1
+	}
+	return 0
+}
+
+// deducirSigno devuelve el signo que la transitividad impone a cmp(a, c) conociendo cmp(a, b) y cmp(b, c), y
+// false si no impone ninguno
+func deducirSigno(ab, bc int) (int, bool) {
+	switch {
+	case ab == bc:
+		return ab, true
+	case ab == 0:
+		return bc, true
+	case bc == 0:
+		return ab, true
+	}
+	return 0, false
+}
+
+func crearValidadorComparador[K comparable](cmp func(K, K) int) func(K, K) int {
+	// vistos guarda, para cada clave, el signo de su comparación con cada clave con la que se comparó
+	vistos := make(map[K]map[K]int)
+	registrar := func(a, b K, res int) {
+		if vistos[a] == nil {
+			vistos[a] = make(map[K]int)
+		}
+		vistos[a][b] = res
+	}
+	return func(a, b K) int {
+		res := signo(cmp(a, b))
+		if a == b && res != 0 {
+			panic(fmt.Errorf("%w: cmp(%v, %v) = %d, pero una clave comparada con si misma debe dar 0",
+				ErrComparadorInconsistente, a, a, res))
+		}
+		if inverso := signo(cmp(b, a)); inverso != -res {
+			panic(fmt.Errorf("%w: cmp(%v, %v) = %d pero cmp(%v, %v) = %d, no es antisimetrica",
+				ErrComparadorInconsistente, a, b, res, b, a, inverso))
+		}
+		if anterior, ok := vistos[a][b]; ok {
+			if anterior != res {
+				panic(fmt.Errorf("%w: cmp(%v, %v) dio %d y luego %d",
+					ErrComparadorInconsistente, a, b, anterior, res))
+			}
+			return res
+		}
+		for c, ac := range vistos[a] {
+			cb, ok := vistos[c][b]
+			if !ok {
+				continue
+			}
+			if esperado, ok := deducirSigno(ac, cb); ok && esperado != res {
+				panic(fmt.Errorf("%w: cmp(%v, %v) = %d y cmp(%v, %v) = %d pero cmp(%v, %v) = %d, no es transitiva",
+					ErrComparadorInconsistente, a, c, ac, c, b, cb, a, b, res))
+			}
+		}
+		registrar(a, b, res)
+		registrar(b, a, -res)
+		return res
+	}
+}
+
+func (a *abb[K, V]) Validar() error {
+	cmp := a.cmp
+	if a.cmpOriginal != nil {
+		cmp = a.cmpOriginal
+	}
+	nodos, err := validarSubarbol(a.raiz, nil, nil, cmp)
+	if err != nil {
+		return err
+	}
+	if nodos != a.cantidad {
+		return fmt.Errorf("la cantidad es %d pero el arbol tiene %d nodos", a.cantidad, nodos)
+	}
+	return nil
+}
+
+// validarSubarbol verifica que todas las claves del subárbol sean mayores a la del ancestro menor y menores a la
+// del ancestro mayor (los ancestros de los que el subárbol es descendiente derecho e izquierdo, respectivamente),
+// y devuelve la cantidad de nodos
+func validarSubarbol[K comparable, V any](n *nodoABB[K, V], menor *nodoABB[K, V], mayor *nodoABB[K, V], cmp func(K, K) int) (int, error) {
+	if n == nil {
+		return 0, nil
+	}
+	if menor != nil && cmp(n.clave, menor.clave) <= 0 {
+		return 0, fmt.Errorf("la clave %v esta en el subarbol derecho de %v pero no es mayor", n.clave, menor.clave)
+	}
+	if mayor != nil && cmp(n.clave, mayor.clave) >= 0 {
+		return 0, fmt.Errorf("la clave %v esta en el subarbol izquierdo de %v pero no es menor", n.clave, mayor.clave)
+	}
+	izq, err := validarSubarbol(n.izq, menor, n, cmp)
+	if err != nil {
+		return 0, err
+	}
+	der, err := validarSubarbol(n.der, n, mayor, cmp)
+	if err != nil {
+		return 0, err
+	}
+	return izq + der + 1, nil
+}
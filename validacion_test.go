@@ -0,0 +1,113 @@
+package diccionario_test
+
+import (
+	"cmp"
+	"errors"
+	"math/rand"
+	TDADiccionario "tdas/diccionario"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// recuperarError ejecuta la función y devuelve el error con el que entró en pánico, o nil si no lo hizo
+func recuperarError(f func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = r.(error)
+		}
+	}()
+	f()
+	return nil
+}
+
+func TestValidacionComparadorCorrecto(t *testing.T) {
+	t.Log("Con un comparador válido la validación no interfiere y Validar no encuentra errores")
+	dic := TDADiccionario.CrearABB[int, int](cmp.Compare, TDADiccionario.ConValidacionComparador())
+	for _, clave := range rand.New(rand.NewSource(1)).Perm(500) {
+		dic.Guardar(clave, clave)
+	}
+	for i := 0; i < 500; i += 2 {
+		dic.Borrar(i)
+	}
+	require.EqualValues(t, 250, dic.Cantidad())
+	require.NoError(t, dic.Validar())
+	require.NoError(t, TDADiccionario.CrearABB[int, int](cmp.Compare).Validar())
+}
+
+func TestValidacionComparadorNoAntisimetrico(t *testing.T) {
+	t.Log("Un comparador que dice que todo es mayor se detecta en la primera comparación")
+	siempreMayor := func(a, b int) int { return 1 }
+	dic := TDADiccionario.CrearABB[int, int](siempreMayor, TDADiccionario.ConValidacionComparador())
+	dic.Guardar(1, 1)
+	err := recuperarError(func() { dic.Guardar(2, 2) })
+	require.ErrorIs(t, err, TDADiccionario.ErrComparadorInconsistente)
+	require.ErrorContains(t, err, "antisimetrica")
+}
+
+func TestValidacionComparadorNoReflexivo(t *testing.T) {
+	t.Log("Un comparador que no da 0 para una misma clave se detecta")
+	menorOIgual := func(a, b int) int {
+		if a <= b {
+			return -1
+		}
+		return 1
+	}
+	dic := TDADiccionario.CrearABB[int, int](menorOIgual, TDADiccionario.ConValidacionComparador())
+	dic.Guardar(1, 1)
+	err := recuperarError(func() { dic.Pertenece(1) })
+	require.ErrorIs(t, err, TDADiccionario.ErrComparadorInconsistente)
+}
+
+func TestValidacionComparadorNoTransitivo(t *testing.T) {
+	t.Log("Un comparador circular, como piedra, papel o tijera, se detecta al comparar los tres pares")
+	circular := func(a, b int) int {
+		if a == b {
+			return 0
+		}
+		if (a-b+3)%3 == 1 {
+			return 1
+		}
+		return -1
+	}
+	dic := TDADiccionario.CrearABB[int, int](circular, TDADiccionario.ConValidacionComparador())
+	// Deja a 0 en la raíz con 1 a su izquierda, después de haber visto que 1 < 2 y 2 < 0
+	dic.Guardar(1, 1)
+	dic.Guardar(2, 2)
+	dic.Borrar(1)
+	dic.Guardar(0, 0)
+	dic.Guardar(1, 1)
+	dic.Borrar(2)
+	err := recuperarError(func() { dic.Pertenece(1) })
+	require.ErrorIs(t, err, TDADiccionario.ErrComparadorInconsistente)
+	require.ErrorContains(t, err, "transitiva")
+}
+
+func TestValidacionComparadorCambiante(t *testing.T) {
+	t.Log("Un comparador que cambia de resultado con el tiempo se detecta, y sin validación Validar lo reporta")
+	invertido := false
+	cambiante := func(a, b int) int {
+		if invertido {
+			return cmp.Compare(b, a)
+		}
+		return cmp.Compare(a, b)
+	}
+
+	dic := TDADiccionario.CrearABB[int, int](cambiante, TDADiccionario.ConValidacionComparador())
+	dic.Guardar(1, 1)
+	dic.Guardar(2, 2)
+	invertido = true
+	err := recuperarError(func() { dic.Pertenece(2) })
+	require.ErrorIs(t, err, TDADiccionario.ErrComparadorInconsistente)
+
+	invertido = false
+	sinValidar := TDADiccionario.CrearABB[int, int](cambiante)
+	sinValidar.Guardar(1, 1)
+	sinValidar.Guardar(2, 2)
+	invertido = true
+	sinValidar.Guardar(3, 3)
+	err = sinValidar.Validar()
+	require.Error(t, err)
+	require.False(t, errors.Is(err, TDADiccionario.ErrComparadorInconsistente))
+	require.ErrorContains(t, err, "la clave")
+}
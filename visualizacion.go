@@ -0,0 +1,238 @@
+package diccionario
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Visualizable es implementado por los diccionarios basados en árboles, para poder inspeccionar su forma al
+// depurar. Los que se crean como DiccionarioOrdenado (splay, scapegoat y árbol B) también lo implementan, y se
+// puede acceder a sus primitivas con una aserción de tipo
+type Visualizable[K comparable, V any] interface {
+	// ExportarDOT escribe el árbol como un grafo de Graphviz, etiquetando cada nodo con formatear y con la
+	// información de balanceo de la variante si la tiene (altura en los AVL, prioridad en los treaps). En los
+	// árboles binarios, los hijos nulos se dibujan como puntos
+	ExportarDOT(w io.Writer, formatear func(clave K, dato V) string) error
+
+	// ExportarASCII escribe el árbol acostado para verlo en una terminal: la raíz queda en la primera columna,
+	// los hijos derechos arriba de su padre y los izquierdos abajo. Si el árbol está vacío no escribe nada
+	ExportarASCII(w io.Writer, formatear func(clave K, dato V) string) error
+}
+
+// vistaNodo es la representación de un nodo que usan los exportadores, independiente de la variante del árbol.
+// En los árboles binarios hijos tiene siempre dos posiciones, que pueden ser nil; en los nodos hoja de un árbol
+// B está vacío
+type vistaNodo struct {
+	etiqueta  string
+	anotacion string
+	hijos     []*vistaNodo
+}
+
+func vistaABB[K comparable, V any](n *nodoABB[K, V], formatear func(K, V) string) *vistaNodo {
+	if n == nil {
+		return nil
+	}
+	return &vistaNodo{
+		etiqueta: formatear(n.clave, n.dato),
+		hijos:    []*vistaNodo{vistaABB(n.izq, formatear), vistaABB(n.der, formatear)},
+	}
+}
+
+func vistaAVL[K comparable, V any, A any](n *nodoAVL[K, V, A], formatear func(K, V) string) *vistaNodo {
+	if n == nil {
+		return nil
+	}
+	return &vistaNodo{
+		etiqueta:  formatear(n.clave, n.dato),
+		anotacion: fmt.Sprintf("h=%d", n.altura),
+		hijos:     []*vistaNodo{vistaAVL(n.izq, formatear), vistaAVL(n.der, formatear)},
+	}
+}
+
+func vistaTreap[K comparable, V any](n *nodoTreap[K, V], formatear func(K, V) string) *vistaNodo {
+	if n == nil {
+		return nil
+	}
+	return &vistaNodo{
+		etiqueta:  formatear(n.clave, n.dato),
+		anotacion: fmt.Sprintf("p=%d", n.prioridad),
+		hijos:     []*vistaNodo{vistaTreap(n.izq, formatear), vistaTreap(n.der, formatear)},
+	}
+}
+
+// vistaB etiqueta cada nodo con todas sus claves separadas por barras
+func vistaB[K comparable, V any](n *nodoB[K, V], formatear func(K, V) string) *vistaNodo {
+	if len(n.claves) == 0 {
+		return nil
+	}
+	etiquetas := make([]string, len(n.claves))
+	for i := range n.claves {
+		etiquetas[i] = formatear(n.claves[i], n.datos[i])
+	}
+	vista := &vistaNodo{etiqueta: strings.Join(etiquetas, " | ")}
+	for _, hijo := range n.hijos {
+		vista.hijos = append(vista.hijos, vistaB(hijo, formatear))
+	}
+	return vista
+}
+
+// escaparDOT escapa el texto para usarlo dentro de una etiqueta entre comillas de Graphviz
+func escaparDOT(texto string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(texto)
+}
+
+// exportarDOT arma todo el grafo en memoria y lo escribe con una única llamada, numerando los nodos en preorden
+func exportarDOT(w io.Writer, raiz *vistaNodo) error {
+	var b strings.Builder
+	b.WriteString("digraph arbol {\n\tnode [shape=box];\n")
+	siguiente := 0
+	var escribir func(n *vistaNodo) int
+	escribir = func(n *vistaNodo) int {
+		id := siguiente
+		siguiente++
+		if n == nil {
+			fmt.Fprintf(&b, "\tn%d [shape=point];\n", id)
+			return id
+		}
+		etiqueta := escaparDOT(n.etiqueta)
+		if n.anotacion != "" {
+			etiqueta += `\n` + escaparDOT(n.anotacion)
+		}
+		fmt.Fprintf(&b, "\tn%d [label=\"%s\"];\n", id, etiqueta)
+		for _, hijo := range n.hijos {
+			fmt.Fprintf(&b, "\tn%d -> n%d;\n", id, escribir(hijo))
+		}
+		return id
+	}
+	if raiz != nil {
+		escribir(raiz)
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// exportarASCII arma el dibujo en memoria y lo escribe con una única llamada. La primera mitad de los hijos se
+// dibuja debajo del nodo y la segunda arriba, de forma que leído de abajo hacia arriba queden en orden
+func exportarASCII(w io.Writer, raiz *vistaNodo) error {
+	if raiz == nil {
+		return nil
+	}
+	var b strings.Builder
+	escribirASCII(&b, raiz, "", "", false, false)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// escribirASCII escribe el subárbol de n. lineaArriba y lineaAbajo indican si la línea vertical del padre pasa
+// por arriba o por abajo de n, y determinan tanto el conector de n como las líneas que continúan entre sus hijos
+func escribirASCII(b *strings.Builder, n *vistaNodo, prefijo string, conector string, lineaArriba bool, lineaAbajo bool) {
+	prefijoArriba, prefijoAbajo := prefijo, prefijo
+	if conector != "" {
+		prefijoArriba += lineaVertical(lineaArriba)
+		prefijoAbajo += lineaVertical(lineaAbajo)
+	}
+	mitad := (len(n.hijos) + 1) / 2
+	arriba := hijosNoNulos(n.hijos[mitad:])
+	abajo := hijosNoNulos(n.hijos[:mitad])
+
+	for i := len(arriba) - 1; i >= 0; i-- {
+		primero := i == len(arriba)-1
+		escribirASCII(b, arriba[i], prefijoArriba, conectorASCII(!primero, true), !primero, true)
+	}
+	b.WriteString(prefijo + conector + n.etiqueta)
+	if n.anotacion != "" {
+		b.WriteString(" [" + n.anotacion + "]")
+	}
+	b.WriteString("\n")
+	for i := len(abajo) - 1; i >= 0; i-- {
+		escribirASCII(b, abajo[i], prefijoAbajo, conectorASCII(true, i > 0), true, i > 0)
+	}
+}
+
+func lineaVertical(hayLinea bool) string {
+	if hayLinea {
+		return "│   "
+	}
+	return "    "
+}
+
+// conectorASCII elige el conector de un hijo según si la línea de su padre sigue por arriba y por abajo de él
+func conectorASCII(lineaArriba bool, lineaAbajo bool) string {
+	switch {
+	case lineaArriba && lineaAbajo:
+		return "├── "
+	case lineaArriba:
+		return "└── "
+	default:
+		return "┌── "
+	}
+}
+
+func hijosNoNulos(hijos []*vistaNodo) []*vistaNodo {
+	var noNulos []*vistaNodo
+	for _, hijo := range hijos {
+		if hijo != nil {
+			noNulos = append(noNulos, hijo)
+		}
+	}
+	return noNulos
+}
+
+func (a *abb[K, V]) ExportarDOT(w io.Writer, formatear func(K, V) string) error {
+	return exportarDOT(w, vistaABB(a.raiz, formatear))
+}
+
+func (a *abb[K, V]) ExportarASCII(w io.Writer, formatear func(K, V) string) error {
+	return exportarASCII(w, vistaABB(a.raiz, formatear))
+}
+
+// ExportarDOT recorre el árbol directamente, sin splayear, por lo que no modifica su forma
+func (s *splay[K, V]) ExportarDOT(w io.Writer, formatear func(K, V) string) error {
+	return exportarDOT(w, vistaABB(s.raiz, formatear))
+}
+
+func (s *splay[K, V]) ExportarASCII(w io.Writer, formatear func(K, V) string) error {
+	return exportarASCII(w, vistaABB(s.raiz, formatear))
+}
+
+func (s *scapegoat[K, V]) ExportarDOT(w io.Writer, formatear func(K, V) string) error {
+	return s.arbol.ExportarDOT(w, formatear)
+}
+
+func (s *scapegoat[K, V]) ExportarASCII(w io.Writer, formatear func(K, V) string) error {
+	return s.arbol.ExportarASCII(w, formatear)
+}
+
+func (t *treap[K, V]) ExportarDOT(w io.Writer, formatear func(K, V) string) error {
+	return exportarDOT(w, vistaTreap(t.raiz, formatear))
+}
+
+func (t *treap[K, V]) ExportarASCII(w io.Writer, formatear func(K, V) string) error {
+	return exportarASCII(w, vistaTreap(t.raiz, formatear))
+}
+
+func (a *avl[K, V, A]) ExportarDOT(w io.Writer, formatear func(K, V) string) error {
+	return exportarDOT(w, vistaAVL(a.raiz, formatear))
+}
+
+func (a *avl[K, V, A]) ExportarASCII(w io.Writer, formatear func(K, V) string) error {
+	return exportarASCII(w, vistaAVL(a.raiz, formatear))
+}
+
+func (a *arbolIntervalos[T, V]) ExportarDOT(w io.Writer, formatear func(Intervalo[T], V) string) error {
+	return a.arbol.ExportarDOT(w, formatear)
+}
+
+func (a *arbolIntervalos[T, V]) ExportarASCII(w io.Writer, formatear func(Intervalo[T], V) string) error {
+	return a.arbol.ExportarASCII(w, formatear)
+}
+
+func (a *arbolB[K, V]) ExportarDOT(w io.Writer, formatear func(K, V) string) error {
+	return exportarDOT(w, vistaB(a.raiz, formatear))
+}
+
+func (a *arbolB[K, V]) ExportarASCII(w io.Writer, formatear func(K, V) string) error {
+	return exportarASCII(w, vistaB(a.raiz, formatear))
+}
@@ -0,0 +1,115 @@
+package diccionario_test
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+	TDADiccionario "tdas/diccionario"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func formatearClave(clave int, _ int) string {
+	return fmt.Sprint(clave)
+}
+
+func TestExportarASCIIABB(t *testing.T) {
+	t.Log("El ABB se dibuja acostado, con los hijos derechos arriba y los izquierdos abajo")
+	dic := TDADiccionario.CrearABB[int, int](cmp.Compare)
+	for _, clave := range []int{4, 2, 6, 1, 3, 7} {
+		dic.Guardar(clave, clave)
+	}
+	var b strings.Builder
+	require.NoError(t, dic.ExportarASCII(&b, formatearClave))
+	esperado := "" +
+		"    ┌── 7\n" +
+		"┌── 6\n" +
+		"4\n" +
+		"│   ┌── 3\n" +
+		"└── 2\n" +
+		"    └── 1\n"
+	require.EqualValues(t, esperado, b.String())
+
+	b.Reset()
+	require.NoError(t, TDADiccionario.CrearABB[int, int](cmp.Compare).ExportarASCII(&b, formatearClave))
+	require.Empty(t, b.String())
+}
+
+func TestExportarDOTABB(t *testing.T) {
+	t.Log("El grafo DOT tiene un nodo por clave, marca los hijos nulos y escapa las etiquetas")
+	dic := TDADiccionario.CrearABB[string, int](strings.Compare)
+	dic.Guardar("b", 1)
+	dic.Guardar("a\"c", 2)
+	var b strings.Builder
+	require.NoError(t, dic.ExportarDOT(&b, func(clave string, dato int) string { return fmt.Sprintf("%s=%d", clave, dato) }))
+	esperado := "digraph arbol {\n" +
+		"\tnode [shape=box];\n" +
+		"\tn0 [label=\"b=1\"];\n" +
+		"\tn1 [label=\"a\\\"c=2\"];\n" +
+		"\tn2 [shape=point];\n" +
+		"\tn1 -> n2;\n" +
+		"\tn3 [shape=point];\n" +
+		"\tn1 -> n3;\n" +
+		"\tn0 -> n1;\n" +
+		"\tn4 [shape=point];\n" +
+		"\tn0 -> n4;\n" +
+		"}\n"
+	require.EqualValues(t, esperado, b.String())
+}
+
+func TestExportarAlturasAVL(t *testing.T) {
+	t.Log("Los árboles basados en AVL anotan la altura de cada nodo")
+	dic := TDADiccionario.CrearDiccionarioAgregado[int, int](cmp.Compare,
+		TDADiccionario.Monoide[int]{Neutro: 0, Combinar: func(a, b int) int { return a + b }})
+	for i := 1; i <= 3; i++ {
+		dic.Guardar(i, i)
+	}
+	var b strings.Builder
+	require.NoError(t, dic.ExportarASCII(&b, formatearClave))
+	require.EqualValues(t, "┌── 3 [h=1]\n2 [h=2]\n└── 1 [h=1]\n", b.String())
+
+	b.Reset()
+	require.NoError(t, dic.ExportarDOT(&b, formatearClave))
+	require.Contains(t, b.String(), "n0 [label=\"2\\nh=2\"];")
+}
+
+func TestExportarVariantes(t *testing.T) {
+	t.Log("Todas las variantes balanceadas pueden exportarse, incluidas las que se crean como DiccionarioOrdenado")
+	variantes := map[string]TDADiccionario.DiccionarioOrdenado[int, int]{
+		"Treap":     TDADiccionario.CrearTreapConSemilla[int, int](cmp.Compare, 1),
+		"Splay":     TDADiccionario.CrearSplay[int, int](cmp.Compare),
+		"Scapegoat": TDADiccionario.CrearScapegoat[int, int](cmp.Compare, 0.6),
+		"ArbolB":    TDADiccionario.CrearArbolB[int, int](cmp.Compare, 2),
+	}
+	for nombre, dic := range variantes {
+		for i := 0; i < 20; i++ {
+			dic.Guardar(i, i)
+		}
+		visualizable, ok := dic.(TDADiccionario.Visualizable[int, int])
+		require.True(t, ok, nombre)
+
+		var b strings.Builder
+		require.NoError(t, visualizable.ExportarASCII(&b, formatearClave))
+		for i := 0; i < 20; i++ {
+			require.Contains(t, b.String(), fmt.Sprint(i), nombre)
+		}
+		b.Reset()
+		require.NoError(t, visualizable.ExportarDOT(&b, formatearClave))
+		require.True(t, strings.HasPrefix(b.String(), "digraph arbol {"), nombre)
+	}
+	var b strings.Builder
+	require.NoError(t, variantes["Treap"].(TDADiccionario.Treap[int, int]).ExportarASCII(&b, formatearClave))
+	require.Contains(t, b.String(), "[p=")
+}
+
+func TestExportarArbolB(t *testing.T) {
+	t.Log("Los nodos del árbol B muestran todas sus claves y sus hijos se reparten arriba y abajo")
+	dic := TDADiccionario.CrearArbolB[int, int](cmp.Compare, 2)
+	for i := 1; i <= 4; i++ {
+		dic.Guardar(i, i)
+	}
+	var b strings.Builder
+	require.NoError(t, dic.(TDADiccionario.Visualizable[int, int]).ExportarASCII(&b, formatearClave))
+	require.EqualValues(t, "┌── 3 | 4\n2\n└── 1\n", b.String())
+}